@@ -0,0 +1,205 @@
+package check
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/jeffalyanak/check_https_go/tlsmap"
+)
+
+// CheckALPN function reports the protocol negotiated over ALPN for the
+// fetched response and returns the result. Anything but HTTP/2 being
+// negotiated is critical if requireH2 is set.
+func (h *HTTPCheck) CheckALPN(requireH2 bool) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if h.resp.TLS == nil {
+		r.Error = errors.New("TLS error: no connection state")
+		return r
+	}
+
+	negotiated := h.resp.TLS.NegotiatedProtocol
+	r.VerboseValue = "HTTP version used: " + h.resp.Proto + "\n"
+
+	flag := 0.0
+	if negotiated == "h2" {
+		flag = 1
+	}
+	if err := h.PerfData.Add("http2", flag, ""); err != nil {
+		r.Error = err
+		return r
+	}
+
+	if negotiated == "" {
+		r.Value = "No protocol negotiated over ALPN, " + h.resp.Proto + " used"
+	} else {
+		r.Value = "Negotiated " + negotiated + " (" + tlsmap.ALPN(negotiated) + ")"
+	}
+
+	if requireH2 && negotiated != "h2" {
+		r.ReturnCode = 2
+		r.Value = "HTTP/2 required, " + r.Value
+	}
+
+	return r
+}
+
+// CheckEachHTTPVersion function fetches the URL again over HTTP/1.1 alone and
+// over HTTP/2 alone, runs the status and content checks on each response,
+// and returns the result. Either failing is reported with the worst of its
+// return codes. A server that does not offer HTTP/2 is only noted.
+func (h *HTTPCheck) CheckEachHTTPVersion(userStatusCodes string, checkString string) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	http1, http2 := new(http.Protocols), new(http.Protocols)
+	http1.SetHTTP1(true)
+	http2.SetHTTP2(true)
+
+	versions := []struct {
+		name      string
+		metric    string
+		protocols *http.Protocols
+	}{
+		{"HTTP/1.1", "http1_1_ok", http1},
+		{"HTTP/2", "http2_ok", http2},
+	}
+
+	var summary []string
+	for _, v := range versions {
+		sub := &HTTPCheck{
+			URL:           h.URL,
+			RootCAs:       h.RootCAs,
+			Certificates:  h.Certificates,
+			TraceID:       h.TraceID,
+			httpProtocols: v.protocols,
+		}
+
+		var fetched Result
+		if v.protocols == http2 {
+			offered, err := h.offersHTTP2()
+			switch {
+			case err != nil:
+				fetched.Error = err
+			case !offered:
+				summary = append(summary, v.name+" not offered")
+				r.VerboseValue += v.name + ":\n  not offered\n"
+				continue
+			}
+		}
+		if fetched.Error == nil {
+			fetched = sub.Fetch(h.redirects, h.userAgent, int(h.timeout.Seconds()))
+		}
+		r.VerboseValue += v.name + ":\n" + indent(fetched.VerboseValue)
+
+		ok := 1.0
+		switch {
+		case fetched.Error != nil:
+			ok = 0
+			r.Findings = append(r.Findings, Finding{Rule: v.name, ReturnCode: 2, Message: v.name + " request failed: " + fetched.Error.Error()})
+			summary = append(summary, v.name+" failed")
+		case fetched.ReturnCode != 0:
+			ok = 0
+			r.Findings = append(r.Findings, Finding{Rule: v.name, ReturnCode: fetched.ReturnCode, Message: v.name + ": " + fetched.Value})
+			summary = append(summary, v.name+" failed")
+		default:
+			status := sub.CheckStatus(userStatusCodes)
+			content := sub.CheckContent(checkString)
+			if status.Error != nil {
+				r.Error = status.Error
+				return r
+			}
+			if status.ReturnCode != 0 {
+				ok = 0
+				r.Findings = append(r.Findings, Finding{Rule: v.name, ReturnCode: status.ReturnCode, Message: v.name + " returned " + sub.resp.Status})
+			}
+			if content.ReturnCode != 0 {
+				ok = 0
+				r.Findings = append(r.Findings, Finding{Rule: v.name, ReturnCode: content.ReturnCode, Message: v.name + ": " + content.Value})
+			}
+			summary = append(summary, v.name+" "+sub.resp.Status)
+			r.VerboseValue += "  " + sub.resp.Proto + " " + sub.resp.Status + ", " + strings.TrimSuffix(content.VerboseValue, "\n") + "\n"
+		}
+		if err := h.PerfData.Add(v.metric, ok, ""); err != nil {
+			r.Error = err
+			return r
+		}
+	}
+
+	for _, f := range r.Findings {
+		r.ReturnCode = WorstReturnCode(r.ReturnCode, f.ReturnCode)
+		r.VerboseValue += "Finding: " + f.Message + "\n"
+	}
+
+	switch r.ReturnCode {
+	case 0:
+		r.Value = "HTTP versions okay, " + strings.Join(summary, ", ")
+	case 1:
+		r.Value = "HTTP versions warning, " + strings.Join(summary, ", ")
+	case 2:
+		r.Value = "HTTP versions critical, " + strings.Join(summary, ", ")
+	default:
+		r.Value = "HTTP versions unknown, " + strings.Join(summary, ", ")
+	}
+
+	return r
+}
+
+// alertNoApplicationProtocol is sent by servers that support none of the
+// offered ALPN protocols
+const alertNoApplicationProtocol = 120
+
+// offersHTTP2 performs a TLS handshake with the host offering only h2 over
+// ALPN and reports whether the server agreed to it. A server that refuses
+// the handshake for want of a common protocol doesn't offer it.
+func (h *HTTPCheck) offersHTTP2() (bool, error) {
+	addr, serverName := h.target()
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: h.timeout},
+		Config: &tls.Config{
+			InsecureSkipVerify:   true,
+			ServerName:           serverName,
+			NextProtos:           []string{"h2"},
+			GetClientCertificate: h.getClientCertificate,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		if isRemoteAlert(err) && remoteAlert(err) == tlsmap.Alert(alertNoApplicationProtocol) {
+			return false, nil
+		}
+		if isRemoteAlert(err) {
+			return false, errors.New("TLS handshake failed, server sent " + remoteAlert(err) + " alert")
+		}
+		return false, err
+	}
+	defer conn.Close()
+
+	return conn.(*tls.Conn).ConnectionState().NegotiatedProtocol == "h2", nil
+}
+
+// indent prefixes each line of s with two spaces.
+func indent(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "  " + strings.ReplaceAll(strings.TrimSuffix(s, "\n"), "\n", "\n  ") + "\n"
+}
@@ -0,0 +1,72 @@
+package check
+
+import (
+	"crypto/tls"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCheckEachHTTPVersion(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), leafTemplate(3))
+
+	// Fails requests over HTTP/2 only
+	brokenH2 := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 {
+			http.Error(w, "broken", http.StatusInternalServerError)
+			return
+		}
+		okHandler(w, r)
+	})
+
+	tests := []struct {
+		name     string
+		h2       bool
+		handler  http.Handler
+		wantCode int
+		want     string
+		details  string
+	}{
+		{"both versions", true, okHandler, 0, "HTTP versions okay, HTTP/1.1 200 OK, HTTP/2 200 OK", "HTTP/2.0 200 OK"},
+		{"HTTP/2 not offered", false, okHandler, 0, "HTTP versions okay, HTTP/1.1 200 OK, HTTP/2 not offered", "HTTP/2:\n  not offered\n"},
+		{"HTTP/2 failing", true, brokenH2, 2, "HTTP versions critical, HTTP/1.1 200 OK, HTTP/2 500 Internal Server Error", "Finding: HTTP/2 returned 500 Internal Server Error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, tt.h2, tt.handler)
+			h := fetchTest(t, srv, ca)
+
+			r := h.CheckEachHTTPVersion("200", "hello")
+			if r.Error != nil {
+				t.Fatalf("CheckEachHTTPVersion: %v", r.Error)
+			}
+			if r.ReturnCode != tt.wantCode || r.Value != tt.want {
+				t.Errorf("CheckEachHTTPVersion = %d %q, want %d %q", r.ReturnCode, r.Value, tt.wantCode, tt.want)
+			}
+			if !strings.Contains(r.VerboseValue, tt.details) {
+				t.Errorf("CheckEachHTTPVersion details = %q, want %q", r.VerboseValue, tt.details)
+			}
+		})
+	}
+}
+
+func TestCheckALPN(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), leafTemplate(4))
+
+	for _, h2 := range []bool{true, false} {
+		srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, h2, okHandler)
+		h := fetchTest(t, srv, ca)
+
+		r := h.CheckALPN(true)
+		wantCode, want := 0, "Negotiated h2"
+		if !h2 {
+			wantCode, want = 2, "HTTP/2 required, Negotiated http/1.1"
+		}
+		if r.ReturnCode != wantCode || !strings.HasPrefix(r.Value, want) {
+			t.Errorf("CheckALPN with h2 %v = %d %q, want %d %q", h2, r.ReturnCode, r.Value, wantCode, want)
+		}
+	}
+}
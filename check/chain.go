@@ -0,0 +1,124 @@
+package check
+
+import (
+	"crypto/x509"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Chain failure classes, used as the perfdata flag for a failed verification.
+const (
+	chainUnknownAuthority    = "chain_unknown_authority"
+	chainHostnameMismatch    = "chain_hostname_mismatch"
+	chainExpired             = "chain_expired"
+	chainExpiredIntermediate = "chain_expired_intermediate"
+	chainNotYetValid         = "chain_not_yet_valid"
+	chainIncompatibleUsage   = "chain_incompatible_usage"
+	chainInvalid             = "chain_invalid"
+)
+
+// CheckChain function verifies the served certificate chain against the
+// system roots, or RootCAs when set, and returns the result. Any failure to
+// build a trusted chain for the host is critical.
+func (h *HTTPCheck) CheckChain() Result {
+	var r Result
+
+	h.chains = nil
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if h.resp.TLS == nil || len(h.resp.TLS.PeerCertificates) == 0 {
+		r.Error = errors.New("TLS error: no certificates returned")
+		return r
+	}
+
+	certs := h.resp.TLS.PeerCertificates
+	now := time.Now()
+
+	chains, err := h.verifyChain(now)
+	if err != nil {
+		flag, msg := classifyChainError(err, certs[0], now)
+		if err := h.PerfData.Add("chain_valid", 0, ""); err != nil {
+			r.Error = err
+			return r
+		}
+		if err := h.PerfData.Add(flag, 1, ""); err != nil {
+			r.Error = err
+			return r
+		}
+
+		r.ReturnCode = 2
+		r.Value = "Chain critical, " + msg
+		r.VerboseValue = "Chain verification failed: " + err.Error() + "\n"
+		return r
+	}
+
+	h.chains = chains
+	if err := h.PerfData.Add("chain_valid", 1, ""); err != nil {
+		r.Error = err
+		return r
+	}
+
+	chain := chains[0]
+	r.ReturnCode = 0
+	r.Value = "Chain okay, trusted via " + chain[len(chain)-1].Subject.String()
+
+	r.VerboseValue = "Verified chain:\n"
+	for i, c := range chain {
+		r.VerboseValue += "  " + strconv.Itoa(i) + ": " + c.Subject.String() + "\n"
+	}
+
+	return r
+}
+
+// verifyChain builds chains from the served certificates to the roots for the
+// host of the final response.
+func (h *HTTPCheck) verifyChain(now time.Time) ([][]*x509.Certificate, error) {
+	certs := h.resp.TLS.PeerCertificates
+
+	opts := x509.VerifyOptions{
+		DNSName:       h.resp.Request.URL.Hostname(),
+		Roots:         h.RootCAs,
+		Intermediates: x509.NewCertPool(),
+		CurrentTime:   now,
+	}
+	for _, c := range certs[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+
+	return certs[0].Verify(opts)
+}
+
+// classifyChainError maps a verification error to its perfdata flag and a
+// plain description of what is wrong with the chain.
+func classifyChainError(err error, leaf *x509.Certificate, now time.Time) (string, string) {
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalid x509.CertificateInvalidError
+
+	switch {
+	case errors.As(err, &unknownAuthority):
+		return chainUnknownAuthority, "issued by an unknown authority"
+	case errors.As(err, &hostname):
+		return chainHostnameMismatch, "not valid for " + hostname.Host
+	case errors.As(err, &invalid):
+		c := invalid.Cert
+		switch invalid.Reason {
+		case x509.Expired:
+			if now.Before(c.NotBefore) {
+				return chainNotYetValid, c.Subject.String() + " not valid before " + c.NotBefore.Format("January 02, 2006 15:04")
+			}
+			if c.Equal(leaf) {
+				return chainExpired, "certificate expired " + c.NotAfter.Format("January 02, 2006 15:04")
+			}
+			return chainExpiredIntermediate, "intermediate " + c.Subject.String() + " expired " + c.NotAfter.Format("January 02, 2006 15:04")
+		case x509.IncompatibleUsage:
+			return chainIncompatibleUsage, "incompatible key usage for TLS server authentication"
+		}
+	}
+	return chainInvalid, err.Error()
+}
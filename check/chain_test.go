@@ -0,0 +1,67 @@
+package check
+
+import (
+	"crypto/tls"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckChain(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	other := newTestCA(t, newECDSAKey(t))
+	past := time.Now().Add(-24 * time.Hour)
+
+	expiredLeaf := leafTemplate(11)
+	expiredLeaf.NotAfter = past
+	wrongHost := leafTemplate(12)
+	wrongHost.DNSNames, wrongHost.IPAddresses = []string{"example.com"}, nil
+
+	tests := []struct {
+		name  string
+		cert  tls.Certificate
+		roots *testCA
+		code  int
+		value string
+		flag  string
+	}{
+		{"valid", ca.intermediate(t, 20, time.Now().Add(365*24*time.Hour)).issue(t, newECDSAKey(t), leafTemplate(10)), ca, 0, "Chain okay, trusted via CN=Test Root CA,O=check_https_go", ""},
+		{"expired leaf", ca.issue(t, newECDSAKey(t), expiredLeaf), ca, 2, "Chain critical, certificate expired ", chainExpired},
+		{"expired intermediate", ca.intermediate(t, 21, past).issue(t, newECDSAKey(t), leafTemplate(13)), ca, 2, "Chain critical, intermediate CN=Test Intermediate CA,O=check_https_go expired ", chainExpiredIntermediate},
+		{"unknown authority", ca.issue(t, newECDSAKey(t), leafTemplate(14)), other, 2, "Chain critical, issued by an unknown authority", chainUnknownAuthority},
+		{"hostname mismatch", ca.issue(t, newECDSAKey(t), wrongHost), ca, 2, "Chain critical, not valid for 127.0.0.1", chainHostnameMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{tt.cert}}, false, okHandler)
+			h := fetchTest(t, srv, tt.roots)
+
+			r := h.CheckChain()
+			if r.Error != nil {
+				t.Fatalf("CheckChain: %v", r.Error)
+			}
+			if r.ReturnCode != tt.code || !strings.HasPrefix(r.Value, tt.value) {
+				t.Errorf("CheckChain = %d %q, want %d %q", r.ReturnCode, r.Value, tt.code, tt.value)
+			}
+
+			want := map[string]float64{"chain_valid": 1}
+			if tt.flag != "" {
+				want = map[string]float64{"chain_valid": 0, tt.flag: 1}
+			}
+			got := metrics(h)
+			for label, v := range want {
+				if value, ok := got[label]; !ok || value != v {
+					t.Errorf("perfdata %s = %v, want %v", label, value, v)
+				}
+			}
+			for label := range got {
+				if strings.HasPrefix(label, "chain_") {
+					if _, ok := want[label]; !ok {
+						t.Errorf("perfdata has %s, want only %v", label, want)
+					}
+				}
+			}
+		})
+	}
+}
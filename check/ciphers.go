@@ -0,0 +1,158 @@
+package check
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/jeffalyanak/check_https_go/tlsmap"
+)
+
+// CheckCipherSuites function enumerates the cipher suites the server accepts
+// under each protocol version and returns the result. Any insecure suite is
+// critical. With warnWeak set, weak suites are a warning.
+func (h *HTTPCheck) CheckCipherSuites(warnWeak bool) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if err := h.scanCipherSuites(); err != nil {
+		r.Error = err
+		return r
+	}
+
+	counts := map[tlsmap.Rating]int{}
+	var insecure, weak []string
+	seen := map[uint16]bool{}
+
+	for _, version := range tlsmap.TLSVersions() {
+		suites := h.cipherSuites[version]
+		if len(suites) == 0 {
+			continue
+		}
+
+		r.VerboseValue += tlsmap.TLSVersion(version) + " cipher suites:\n"
+		for _, c := range suites {
+			info, _ := tlsmap.Info(c)
+			r.VerboseValue += "  " + tlsmap.CipherSuite(c) + " (" + info.Rating.String() + ")\n"
+
+			if seen[c] {
+				continue
+			}
+			seen[c] = true
+			counts[info.Rating]++
+
+			switch info.Rating {
+			case tlsmap.Insecure:
+				insecure = append(insecure, tlsmap.CipherSuite(c))
+				r.Findings = append(r.Findings, Finding{Rule: "cipher", ReturnCode: 2, Message: tlsmap.CipherSuite(c) + " is insecure"})
+			case tlsmap.Weak:
+				weak = append(weak, tlsmap.CipherSuite(c))
+				if warnWeak {
+					r.Findings = append(r.Findings, Finding{Rule: "cipher", ReturnCode: 1, Message: tlsmap.CipherSuite(c) + " is weak"})
+				}
+			}
+		}
+	}
+
+	if err := h.PerfData.Add("ciphers_accepted", float64(len(seen)), ""); err != nil {
+		r.Error = err
+		return r
+	}
+	if err := h.PerfData.Add("ciphers_insecure", float64(counts[tlsmap.Insecure]), ""); err != nil {
+		r.Error = err
+		return r
+	}
+	if err := h.PerfData.Add("ciphers_weak", float64(counts[tlsmap.Weak]), ""); err != nil {
+		r.Error = err
+		return r
+	}
+
+	summary := strconv.Itoa(len(seen)) + " suites accepted, " +
+		strconv.Itoa(counts[tlsmap.Recommended]) + " recommended, " +
+		strconv.Itoa(counts[tlsmap.Secure]) + " secure, " +
+		strconv.Itoa(counts[tlsmap.Weak]) + " weak, " +
+		strconv.Itoa(counts[tlsmap.Insecure]) + " insecure"
+
+	switch {
+	case len(seen) == 0:
+		r.ReturnCode = 2
+		r.Value = "Ciphers critical, no cipher suite accepted"
+	case len(insecure) > 0:
+		r.ReturnCode = 2
+		r.Value = "Ciphers critical, insecure " + strings.Join(insecure, ", ") + " enabled, " + summary
+	case warnWeak && len(weak) > 0:
+		r.ReturnCode = 1
+		r.Value = "Ciphers warning, weak " + strings.Join(weak, ", ") + " enabled, " + summary
+	default:
+		r.ReturnCode = 0
+		r.Value = "Ciphers okay, " + summary
+	}
+
+	return r
+}
+
+// scanCipherSuites enumerates the accepted suites of each version once and
+// keeps them, so that later checks can reuse the scan. Versions a protocol
+// scan found rejected are skipped.
+func (h *HTTPCheck) scanCipherSuites() error {
+	if h.cipherSuites != nil {
+		return nil
+	}
+
+	cipherSuites := map[uint16][]uint16{}
+	for _, version := range tlsmap.TLSVersions() {
+		if accepted, scanned := h.protocols[version]; scanned && !accepted {
+			continue
+		}
+
+		suites, err := h.enumerateSuites(version)
+		if err != nil {
+			return errors.New("cipher scan: " + tlsmap.TLSVersion(version) + ": " + err.Error())
+		}
+		if len(suites) > 0 {
+			cipherSuites[version] = suites
+		}
+	}
+
+	h.cipherSuites = cipherSuites
+	return nil
+}
+
+// enumerateSuites finds the suites accepted under version by offering every
+// suite, then repeating without the one the server chose until it refuses.
+func (h *HTTPCheck) enumerateSuites(version uint16) ([]uint16, error) {
+	var accepted []uint16
+	addr, serverName := h.target()
+	offered := probeSuites(version)
+
+	for len(offered) > 0 {
+		sh, err := probeHello(addr, serverName, h.timeout, version, offered)
+		if isHelloRejected(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		remaining := offered[:0:0]
+		for _, c := range offered {
+			if c != sh.cipherSuite {
+				remaining = append(remaining, c)
+			}
+		}
+
+		// A server choosing a suite that wasn't offered would loop forever.
+		if len(remaining) == len(offered) {
+			return nil, errors.New("server chose " + tlsmap.CipherSuite(sh.cipherSuite) + " which was not offered")
+		}
+
+		accepted = append(accepted, sh.cipherSuite)
+		offered = remaining
+	}
+
+	return accepted, nil
+}
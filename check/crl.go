@@ -0,0 +1,225 @@
+package check
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultCRLCacheDir returns the directory CRLs are cached in when none is given.
+func DefaultCRLCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "check_https_go", "crl")
+}
+
+// CheckCRL function checks the leaf certificate against the CRLs named in its
+// CRL distribution points and returns the result. CRLs are cached in
+// cacheDir until their next update, an empty cacheDir disables the cache. A
+// CRL past its next update is a warning.
+func (h *HTTPCheck) CheckCRL(cacheDir string) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if h.resp.TLS == nil || len(h.resp.TLS.PeerCertificates) == 0 {
+		r.Error = errors.New("TLS error: no certificates returned")
+		return r
+	}
+
+	leaf := h.resp.TLS.PeerCertificates[0]
+	issuer := h.issuer()
+	if issuer == nil {
+		r.Error = errors.New("CRL: issuer of " + leaf.Subject.String() + " not found")
+		return r
+	}
+
+	if len(leaf.CRLDistributionPoints) == 0 {
+		r.Error = errors.New("CRL: the certificate names no CRL distribution points")
+		return r
+	}
+
+	// Try each distribution point until one gives a CRL signed by the issuer.
+	var crl *x509.RevocationList
+	var source, cachePath string
+	var fromCache bool
+	var errs []string
+	for _, point := range leaf.CRLDistributionPoints {
+		if !strings.HasPrefix(point, "http://") && !strings.HasPrefix(point, "https://") {
+			continue
+		}
+
+		path := crlCachePath(cacheDir, point)
+		c, cached, err := h.loadCRL(point, path)
+		if err == nil {
+			err = c.CheckSignatureFrom(issuer)
+		}
+		if err != nil {
+			errs = append(errs, point+": "+err.Error())
+			continue
+		}
+
+		crl = c
+		source = point
+		cachePath = path
+		fromCache = cached
+		if cached {
+			source += " (cached)"
+		}
+		break
+	}
+
+	if crl == nil {
+		r.Error = errors.New("CRL: no usable CRL, " + strings.Join(errs, ", "))
+		return r
+	}
+
+	now := time.Now()
+	if !crl.NextUpdate.IsZero() {
+		if err := h.PerfData.Add("crl_next_update", math.Trunc(crl.NextUpdate.Sub(now).Seconds()), "s"); err != nil {
+			r.Error = err
+			return r
+		}
+	}
+
+	r.VerboseValue = "CRL from " + source + ", number " + crl.Number.String() +
+		", this update " + crl.ThisUpdate.Format("January 02, 2006 15:04") +
+		", next update " + crl.NextUpdate.Format("January 02, 2006 15:04") +
+		", " + strconv.Itoa(len(crl.RevokedCertificateEntries)) + " entries\n"
+
+	// Failing to cache only costs a download next time.
+	if cachePath != "" && !fromCache {
+		if err := writeCRLCache(cachePath, crl.Raw); err != nil {
+			r.VerboseValue += "CRL not cached: " + err.Error() + "\n"
+		}
+	}
+
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.SerialNumber.Cmp(leaf.SerialNumber) == 0 {
+			r.ReturnCode = 2
+			r.Value = "CRL critical, revoked " + entry.RevocationTime.Format("January 02, 2006 15:04") +
+				" (" + revocationReason(entry.ReasonCode) + ")"
+			return r
+		}
+	}
+
+	if !crl.NextUpdate.IsZero() && crl.NextUpdate.Before(now) {
+		r.ReturnCode = 1
+		r.Value = "CRL warning, not revoked but the CRL is stale, next update was due " + crl.NextUpdate.Format("January 02, 2006 15:04")
+		return r
+	}
+
+	r.ReturnCode = 0
+	r.Value = "CRL okay, not revoked"
+	return r
+}
+
+// crlCachePath returns the file the CRL at url is cached in under cacheDir,
+// or an empty path if cacheDir is empty.
+func crlCachePath(cacheDir string, url string) string {
+	if cacheDir == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(cacheDir, hex.EncodeToString(sum[:])+".crl")
+}
+
+// loadCRL returns the CRL at url, from the cache file at path while it is
+// current or else downloaded. A cached CRL that is out of date is still
+// returned if downloading a new one fails. The bool reports whether it came
+// from the cache. An empty path disables the cache.
+func (h *HTTPCheck) loadCRL(url string, path string) (*x509.RevocationList, bool, error) {
+	var cached *x509.RevocationList
+
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			if crl, err := parseCRL(data); err == nil {
+				if crl.NextUpdate.After(time.Now()) {
+					return crl, true, nil
+				}
+				cached = crl
+			}
+		}
+	}
+
+	data, err := h.downloadCRL(url)
+	if err != nil {
+		if cached != nil {
+			return cached, true, nil
+		}
+		return nil, false, err
+	}
+
+	crl, err := parseCRL(data)
+	if err != nil {
+		return nil, false, err
+	}
+	return crl, false, nil
+}
+
+// writeCRLCache writes raw to a temporary file next to path and renames it
+// into place, so that a check running at the same time never reads a
+// partly written CRL.
+func writeCRLCache(path string, raw []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(raw); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0644); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// downloadCRL fetches the raw CRL at url.
+func (h *HTTPCheck) downloadCRL(url string) ([]byte, error) {
+	client := &http.Client{Timeout: h.timeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("server returned " + resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// parseCRL parses a CRL in DER or PEM form.
+func parseCRL(data []byte) (*x509.RevocationList, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		data = block.Bytes
+	}
+	return x509.ParseRevocationList(data)
+}
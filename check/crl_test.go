@@ -0,0 +1,81 @@
+package check
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckCRLCache(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+
+	now := time.Now()
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: now.Add(-time.Hour),
+		NextUpdate: now.Add(24 * time.Hour),
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{SerialNumber: big.NewInt(999), RevocationTime: now.Add(-time.Hour)},
+		},
+	}, ca.cert, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	downloads := 0
+	crlServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads++
+		w.Write(crl)
+	}))
+	defer crlServer.Close()
+
+	template := leafTemplate(6)
+	template.CRLDistributionPoints = []string{crlServer.URL + "/ca.crl"}
+	cert := ca.issue(t, newECDSAKey(t), template)
+	srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, false, okHandler)
+
+	cacheDir := filepath.Join(t.TempDir(), "crl")
+	for i, wantSource := range []string{crlServer.URL + "/ca.crl,", crlServer.URL + "/ca.crl (cached),"} {
+		h := fetchTest(t, srv, ca)
+		r := h.CheckCRL(cacheDir)
+		if r.Error != nil || r.ReturnCode != 0 {
+			t.Fatalf("CheckCRL #%d = %d %q, %v", i, r.ReturnCode, r.Value, r.Error)
+		}
+		if !strings.Contains(r.VerboseValue, "CRL from "+wantSource) {
+			t.Errorf("CheckCRL #%d details = %q, want the CRL from %s", i, r.VerboseValue, wantSource)
+		}
+	}
+	if downloads != 1 {
+		t.Errorf("CRL downloaded %d times, want once and then read from the cache", downloads)
+	}
+
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !strings.HasSuffix(entries[0].Name(), ".crl") {
+		t.Errorf("cache holds %v, want a single .crl file and no temporary files", entries)
+	}
+
+	// A cache directory that can't be created is reported, not fatal.
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	h := fetchTest(t, srv, ca)
+	r := h.CheckCRL(filepath.Join(blocker, "crl"))
+	if r.Error != nil || r.ReturnCode != 0 {
+		t.Fatalf("CheckCRL with an unusable cache = %d %q, %v", r.ReturnCode, r.Value, r.Error)
+	}
+	if !strings.Contains(r.VerboseValue, "CRL not cached: ") {
+		t.Errorf("CheckCRL details = %q, want the cache write failure", r.VerboseValue)
+	}
+}
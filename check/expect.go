@@ -0,0 +1,115 @@
+package check
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// Expectations holds assertions CheckExpectations makes about the leaf
+// certificate and who issued it. Empty fields are not checked.
+type Expectations struct {
+	Issuer          string   // Substring of the issuer DN
+	AuthorityKeyID  string   // Hex authority key identifier of the leaf
+	RootFingerprint string   // Hex SHA-256 fingerprint of the root of the verified chain
+	SANs            []string // Names that must all be in the subject alternative names
+	Organization    string   // Organisation in the subject
+}
+
+// CheckExpectations function checks the leaf certificate against Expect and
+// returns the result. Every assertion that doesn't hold is critical.
+func (h *HTTPCheck) CheckExpectations() Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if h.resp.TLS == nil || len(h.resp.TLS.PeerCertificates) == 0 {
+		r.Error = errors.New("TLS error: no certificates returned")
+		return r
+	}
+
+	c := h.resp.TLS.PeerCertificates[0]
+	e := h.Expect
+	add := func(rule string, ok bool, msg string) {
+		code := 0
+		if !ok {
+			code = 2
+		}
+		r.Findings = append(r.Findings, Finding{Rule: rule, ReturnCode: code, Message: msg})
+	}
+
+	if e.Issuer != "" {
+		issuer := c.Issuer.String()
+		ok := strings.Contains(strings.ToLower(issuer), strings.ToLower(e.Issuer))
+		add("issuer", ok, "issuer is "+issuer+", expected "+e.Issuer)
+	}
+
+	if e.AuthorityKeyID != "" {
+		aki := hex.EncodeToString(c.AuthorityKeyId)
+		want := strings.ToLower(strings.ReplaceAll(e.AuthorityKeyID, ":", ""))
+		add("aki", aki == want, "authority key ID is "+aki+", expected "+want)
+	}
+
+	if e.RootFingerprint != "" {
+		want := strings.ToLower(strings.ReplaceAll(e.RootFingerprint, ":", ""))
+		if len(h.chains) == 0 {
+			add("root", false, "no verified chain to find the root in, expected "+want)
+		} else {
+			root := h.chains[0][len(h.chains[0])-1]
+			fingerprint := CertFingerprint(root)
+			add("root", fingerprint == want, "root is "+root.Subject.String()+" sha256 "+fingerprint+", expected "+want)
+		}
+	}
+
+	for _, name := range e.SANs {
+		found := false
+		for _, san := range c.DNSNames {
+			if strings.EqualFold(san, name) {
+				found = true
+				break
+			}
+		}
+		for _, ip := range c.IPAddresses {
+			if ip.String() == name {
+				found = true
+				break
+			}
+		}
+		if found {
+			add("san", true, "subject alternative names include "+name)
+		} else {
+			add("san", false, "subject alternative names lack "+name)
+		}
+	}
+
+	if e.Organization != "" {
+		found := false
+		for _, org := range c.Subject.Organization {
+			if strings.EqualFold(org, e.Organization) {
+				found = true
+				break
+			}
+		}
+		add("organization", found, "subject organisation is "+strings.Join(c.Subject.Organization, ", ")+", expected "+e.Organization)
+	}
+
+	var problems []string
+	for _, f := range r.Findings {
+		r.ReturnCode = WorstReturnCode(r.ReturnCode, f.ReturnCode)
+		if f.ReturnCode != 0 {
+			problems = append(problems, f.Message)
+		}
+		r.VerboseValue += "Expectation " + f.Rule + ": " + f.Message + "\n"
+	}
+
+	if r.ReturnCode == 0 {
+		r.Value = "Expectations okay, certificate matches all assertions"
+	} else {
+		r.Value = "Expectations critical, " + strings.Join(problems, "; ")
+	}
+
+	return r
+}
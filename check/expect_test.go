@@ -0,0 +1,55 @@
+package check
+
+import (
+	"crypto/tls"
+	"testing"
+)
+
+func TestCheckExpectations(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	other := newTestCA(t, newECDSAKey(t))
+	template := leafTemplate(70)
+	template.Subject.Organization = []string{"Example Org"}
+	cert := ca.issue(t, newECDSAKey(t), template)
+	root := CertFingerprint(ca.cert)
+
+	tests := []struct {
+		name   string
+		expect Expectations
+		code   int
+		value  string
+	}{
+		{"all hold", Expectations{Issuer: "test root", RootFingerprint: root, SANs: []string{"LOCALHOST", "127.0.0.1"}, Organization: "example org"}, 0,
+			"Expectations okay, certificate matches all assertions"},
+		{"issuer", Expectations{Issuer: "Other CA"}, 2,
+			"Expectations critical, issuer is CN=Test Root CA,O=check_https_go, expected Other CA"},
+		{"root", Expectations{RootFingerprint: CertFingerprint(other.cert)}, 2,
+			"Expectations critical, root is CN=Test Root CA,O=check_https_go sha256 " + root + ", expected " + CertFingerprint(other.cert)},
+		{"SAN", Expectations{SANs: []string{"localhost", "www.example.com"}}, 2,
+			"Expectations critical, subject alternative names lack www.example.com"},
+		{"organisation", Expectations{Organization: "Acme"}, 2,
+			"Expectations critical, subject organisation is Example Org, expected Acme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := fetchTest(t, newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, false, okHandler), ca)
+			if r := h.CheckChain(); r.ReturnCode != 0 {
+				t.Fatalf("CheckChain: %s", r.Value)
+			}
+			h.Expect = tt.expect
+
+			r := h.CheckExpectations()
+			if r.Error != nil || r.ReturnCode != tt.code || r.Value != tt.value {
+				t.Errorf("CheckExpectations = %d %q, %v, want %d %q", r.ReturnCode, r.Value, r.Error, tt.code, tt.value)
+			}
+		})
+	}
+
+	// Without a verified chain there is no root to compare.
+	h := fetchTest(t, newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, false, okHandler), ca)
+	h.Expect = Expectations{RootFingerprint: root}
+	if r := h.CheckExpectations(); r.ReturnCode != 2 || r.Value != "Expectations critical, no verified chain to find the root in, expected "+root {
+		t.Errorf("CheckExpectations without a chain = %d %q, want the missing root", r.ReturnCode, r.Value)
+	}
+}
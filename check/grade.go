@@ -0,0 +1,353 @@
+package check
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jeffalyanak/check_https_go/tlsmap"
+)
+
+// Grade is an overall rating of the TLS configuration, from A+ down to F
+type Grade int
+
+// Grades from best to worst
+const (
+	GradeAPlus Grade = iota
+	GradeA
+	GradeAMinus
+	GradeB
+	GradeC
+	GradeD
+	GradeE
+	GradeF
+)
+
+// reverse map of Grade to string
+var gradeNames = map[Grade]string{
+	GradeAPlus:  "A+",
+	GradeA:      "A",
+	GradeAMinus: "A-",
+	GradeB:      "B",
+	GradeC:      "C",
+	GradeD:      "D",
+	GradeE:      "E",
+	GradeF:      "F",
+}
+
+// hstsMinAge is the max-age HSTS needs, in seconds, for an A to become an A+.
+const hstsMinAge = 180 * 24 * 60 * 60
+
+// score of each protocol version
+var protocolScores = map[uint16]int{
+	versionSSL30: 80,
+	0x0301:       90,
+	0x0302:       95,
+	versionTLS12: 100,
+	versionTLS13: 100,
+}
+
+// String returns the letter of the grade
+func (g Grade) String() string {
+	return gradeNames[g]
+}
+
+// ParseGrade takes a letter grade like "A-" and returns the Grade.
+func ParseGrade(grade string) (Grade, error) {
+	for g, name := range gradeNames {
+		if strings.EqualFold(name, strings.TrimSpace(grade)) {
+			return g, nil
+		}
+	}
+	return 0, errors.New("unknown grade: " + grade)
+}
+
+// CheckGrade function grades the TLS configuration from its protocol
+// support, cipher strength, key exchange strength, certificate key size and
+// validity and HSTS, and returns the result. It reuses the protocol and cipher scans and
+// the verified chain of earlier checks, running them if need be. A grade
+// below warn is a warning and below crit is critical.
+func (h *HTTPCheck) CheckGrade(warn Grade, crit Grade) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if h.resp.TLS == nil || len(h.resp.TLS.PeerCertificates) == 0 {
+		r.Error = errors.New("TLS error: no certificates returned")
+		return r
+	}
+
+	if err := h.scanProtocols(); err != nil {
+		r.Error = err
+		return r
+	}
+	if err := h.scanCipherSuites(); err != nil {
+		r.Error = err
+		return r
+	}
+
+	// Each deduction is as severe as the grade it caps at would be on its own.
+	grade := GradeA
+	deduct := func(rule string, limit Grade, msg string) {
+		if limit > grade {
+			grade = limit
+		}
+		code := 0
+		switch {
+		case limit > crit:
+			code = 2
+		case limit > warn:
+			code = 1
+		}
+		r.Findings = append(r.Findings, Finding{Rule: rule, ReturnCode: code, Message: msg + ", capped at " + limit.String()})
+	}
+
+	// Protocol support, the average of the best and worst versions
+	best, worst := 0, 100
+	for version, accepted := range h.protocols {
+		if !accepted {
+			continue
+		}
+		if protocolScores[version] > best {
+			best = protocolScores[version]
+		}
+		if protocolScores[version] < worst {
+			worst = protocolScores[version]
+		}
+	}
+	protocolScore := 0
+	if best > 0 {
+		protocolScore = (best + worst) / 2
+	}
+
+	// Cipher strength, the average of the strongest and weakest suites
+	suites := map[uint16]tlsmap.SuiteInfo{}
+	var codes []uint16
+	for _, accepted := range h.cipherSuites {
+		for _, c := range accepted {
+			if _, seen := suites[c]; !seen {
+				suites[c], _ = tlsmap.Info(c)
+				codes = append(codes, c)
+			}
+		}
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+
+	strongest, weakest := 0, 100
+	for _, info := range suites {
+		s := cipherScore(info.KeyBits)
+		if s > strongest {
+			strongest = s
+		}
+		if s < weakest {
+			weakest = s
+		}
+	}
+	cipherStrength := 0
+	if len(suites) > 0 {
+		cipherStrength = (strongest + weakest) / 2
+	}
+
+	// Key exchange strength, from the group negotiated for the fetch. Without
+	// one the secret was sent under the certificate key, which sets it.
+	leaf := h.resp.TLS.PeerCertificates[0]
+	keyBits := equivalentRSABits(leaf)
+	kxBits, kx := keyBits, "RSA key transport"
+	if bits, ok := groupStrengths[h.resp.TLS.CurveID]; ok {
+		kxBits, kx = bits, tlsmap.Group(uint16(h.resp.TLS.CurveID))
+	}
+	keyScore := keyExchangeScore(kxBits)
+
+	score := (3*protocolScore + 3*keyScore + 4*cipherStrength) / 10
+	grade = scoreGrade(score)
+
+	r.VerboseValue = "Grade scores: protocol " + strconv.Itoa(protocolScore) +
+		", key exchange " + strconv.Itoa(keyScore) + " (" + kx + ")" +
+		", cipher strength " + strconv.Itoa(cipherStrength) +
+		", overall " + strconv.Itoa(score) + "\n"
+
+	// Certificate validity
+	if h.chains == nil {
+		if _, err := h.verifyChain(time.Now()); err != nil {
+			_, msg := classifyChainError(err, leaf, time.Now())
+			deduct("certificate", GradeF, "certificate not trusted, "+msg)
+		}
+	}
+
+	// Protocols
+	if h.protocols[versionSSL30] {
+		deduct("protocol", GradeC, "SSL 3.0 enabled")
+	}
+	if h.protocols[0x0301] || h.protocols[0x0302] {
+		deduct("protocol", GradeB, "TLS 1.0 or 1.1 enabled")
+	}
+	if !h.protocols[versionTLS12] && !h.protocols[versionTLS13] {
+		deduct("protocol", GradeC, "neither TLS 1.2 nor TLS 1.3 offered")
+	}
+
+	// Cipher suites
+	anyFS, allFS, anyAEAD := false, true, false
+	for _, c := range codes {
+		info := suites[c]
+		switch {
+		case info.Export, info.Null, info.Anonymous:
+			deduct("cipher", GradeF, tlsmap.CipherSuite(c)+" enabled")
+		case info.Rating == tlsmap.Insecure, info.TripleDES:
+			deduct("cipher", GradeC, tlsmap.CipherSuite(c)+" enabled")
+		}
+		anyFS = anyFS || info.ForwardSecrecy
+		allFS = allFS && info.ForwardSecrecy
+		anyAEAD = anyAEAD || info.AEAD
+	}
+	if len(suites) > 0 && !anyFS {
+		deduct("forward_secrecy", GradeB, "no forward secrecy")
+	} else if !allFS {
+		deduct("forward_secrecy", GradeAMinus, "not every suite has forward secrecy")
+	}
+	if len(suites) > 0 && !anyAEAD {
+		deduct("aead", GradeB, "no AEAD cipher suites")
+	}
+
+	// Certificate key
+	if keyBits < 1024 {
+		deduct("key", GradeF, "certificate key below 1024 bits")
+	} else if keyBits < 2048 {
+		deduct("key", GradeB, "certificate key below 2048 bits")
+	}
+
+	// HSTS turns an A into an A+
+	maxAge := hstsMaxAge(h.resp.Header.Get("Strict-Transport-Security"))
+	if grade == GradeA && maxAge >= hstsMinAge {
+		grade = GradeAPlus
+		r.VerboseValue += "HSTS max-age " + strconv.Itoa(maxAge) + ", raised to A+\n"
+	} else if maxAge < hstsMinAge {
+		r.VerboseValue += "HSTS missing or max-age under 180 days, A+ not awarded\n"
+	}
+
+	for _, f := range r.Findings {
+		r.VerboseValue += "Deduction: " + f.Message + "\n"
+	}
+
+	if err := h.PerfData.Add("grade_score", float64(score), ""); err != nil {
+		r.Error = err
+		return r
+	}
+
+	r.Value = "Grade " + grade.String() + ", score " + strconv.Itoa(score)
+	switch {
+	case grade > crit:
+		r.ReturnCode = 2
+		r.Value += ", below " + crit.String()
+	case grade > warn:
+		r.ReturnCode = 1
+		r.Value += ", below " + warn.String()
+	}
+
+	return r
+}
+
+// scoreGrade turns an overall score into a letter grade.
+func scoreGrade(score int) Grade {
+	switch {
+	case score >= 80:
+		return GradeA
+	case score >= 65:
+		return GradeB
+	case score >= 50:
+		return GradeC
+	case score >= 35:
+		return GradeD
+	case score >= 20:
+		return GradeE
+	}
+	return GradeF
+}
+
+// cipherScore scores the strength of a bulk cipher.
+func cipherScore(bits int) int {
+	switch {
+	case bits == 0:
+		return 0
+	case bits < 128:
+		return 20
+	case bits < 256:
+		return 80
+	}
+	return 100
+}
+
+// groupStrengths is the RSA-equivalent size of each key exchange group. The
+// hybrid groups count as strong as their ML-KEM half.
+var groupStrengths = map[tls.CurveID]int{
+	tls.CurveP256:          3072,
+	tls.CurveP384:          7680,
+	tls.CurveP521:          15360,
+	tls.X25519:             3072,
+	tls.X25519MLKEM768:     7680,
+	tls.SecP256r1MLKEM768:  7680,
+	tls.SecP384r1MLKEM1024: 15360,
+	256:                    2048, // ffdhe2048
+	257:                    3072, // ffdhe3072
+	258:                    4096, // ffdhe4096
+	259:                    6144, // ffdhe6144
+	260:                    8192, // ffdhe8192
+}
+
+// keyExchangeScore scores a key exchange by its RSA-equivalent size.
+func keyExchangeScore(bits int) int {
+	switch {
+	case bits < 512:
+		return 20
+	case bits < 1024:
+		return 40
+	case bits < 2048:
+		return 80
+	case bits < 4096:
+		return 90
+	}
+	return 100
+}
+
+// equivalentRSABits returns the size of RSA key with the strength of the certificate key.
+func equivalentRSABits(c *x509.Certificate) int {
+	switch k := c.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return k.N.BitLen()
+	case *ecdsa.PublicKey:
+		switch bits := k.Curve.Params().BitSize; {
+		case bits >= 384:
+			return 7680
+		case bits >= 256:
+			return 3072
+		default:
+			return 2048
+		}
+	case ed25519.PublicKey:
+		return 3072
+	}
+	return 0
+}
+
+// hstsMaxAge returns the max-age of a Strict-Transport-Security header, 0 if absent.
+func hstsMaxAge(header string) int {
+	for _, directive := range strings.Split(header, ";") {
+		kv := strings.SplitN(strings.TrimSpace(directive), "=", 2)
+		if len(kv) == 2 && strings.EqualFold(kv[0], "max-age") {
+			age, err := strconv.Atoi(strings.Trim(kv[1], `"`))
+			if err == nil {
+				return age
+			}
+		}
+	}
+	return 0
+}
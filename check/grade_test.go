@@ -0,0 +1,73 @@
+package check
+
+import (
+	"crypto/tls"
+	"strings"
+	"testing"
+)
+
+func TestCheckGradeKeyExchange(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), leafTemplate(2))
+
+	tests := []struct {
+		group tls.CurveID
+		want  string
+	}{
+		{tls.X25519, "key exchange 90 (x25519)"},
+		{tls.CurveP384, "key exchange 100 (secp384r1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}, CurvePreferences: []tls.CurveID{tt.group}}, false, okHandler)
+			h := fetchTest(t, srv, ca)
+			r := h.CheckGrade(GradeF, GradeF)
+			if r.Error != nil {
+				t.Fatalf("CheckGrade: %v", r.Error)
+			}
+			if !strings.Contains(r.VerboseValue, tt.want) {
+				t.Errorf("CheckGrade details = %q, want %q", r.VerboseValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckGradeFindings(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), leafTemplate(3))
+	srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS10}, false, okHandler)
+
+	tests := []struct {
+		warn, crit Grade
+		code       int
+	}{
+		{GradeB, GradeF, 0},
+		{GradeA, GradeC, 1},
+		{GradeA, GradeAMinus, 2},
+	}
+
+	for _, tt := range tests {
+		h := fetchTest(t, srv, ca)
+		r := h.CheckGrade(tt.warn, tt.crit)
+		if r.Error != nil {
+			t.Fatalf("CheckGrade: %v", r.Error)
+		}
+
+		found := false
+		for _, f := range r.Findings {
+			if f.Rule == "protocol" && f.Message == "TLS 1.0 or 1.1 enabled, capped at B" {
+				found = true
+				if f.ReturnCode != tt.code {
+					t.Errorf("CheckGrade(%s, %s) finding %q has code %d, want %d", tt.warn, tt.crit, f.Message, f.ReturnCode, tt.code)
+				}
+			}
+		}
+		if !found {
+			t.Fatalf("CheckGrade(%s, %s) findings = %+v, want TLS 1.0 capped at B", tt.warn, tt.crit, r.Findings)
+		}
+		if r.ReturnCode != tt.code {
+			t.Errorf("CheckGrade(%s, %s) = %d %q, want %d", tt.warn, tt.crit, r.ReturnCode, r.Value, tt.code)
+		}
+	}
+}
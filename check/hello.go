@@ -0,0 +1,301 @@
+package check
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/jeffalyanak/check_https_go/tlsmap"
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// Record and handshake types used by the probe
+const (
+	recordAlert           = 21
+	recordHandshake       = 22
+	handshakeClientHello  = 1
+	handshakeServerHello  = 2
+	extServerName         = 0
+	extSupportedGroups    = 10
+	extECPointFormats     = 11
+	extSignatureAlgs      = 13
+	extSupportedVersions  = 43
+	extPSKModes           = 45
+	extKeyShare           = 51
+	extRenegotiationInfo  = 0xff01
+	groupX25519           = 29
+	versionSSL30          = 0x0300
+	versionTLS10          = 0x0301
+	versionTLS12          = 0x0303
+	versionTLS13          = 0x0304
+	maxServerHelloRecords = 8
+)
+
+// errHelloRejected is returned by probeHello when the server refuses the
+// offered version and cipher suites.
+var errHelloRejected = errors.New("handshake rejected")
+
+// alertError is returned by probeHello when the server refuses the offer
+// with an alert, and names the alert it sent.
+type alertError uint8
+
+func (e alertError) Error() string {
+	return "server sent " + tlsmap.Alert(uint8(e)) + " alert"
+}
+
+// isHelloRejected reports whether a probeHello error is the server refusing
+// the offer, rather than a failure to reach it.
+func isHelloRejected(err error) bool {
+	var alert alertError
+	return err == errHelloRejected || errors.As(err, &alert)
+}
+
+// probeGroups and probeSignatureAlgs are offered in every probe so that only
+// the version and cipher suites decide whether the server accepts it.
+var (
+	probeGroups        = []uint16{groupX25519, 23, 24, 25, 256, 257}
+	probeSignatureAlgs = []uint16{0x0403, 0x0503, 0x0603, 0x0804, 0x0805, 0x0806, 0x0401, 0x0501, 0x0601, 0x0203, 0x0201}
+)
+
+// serverHello holds what the server chose in reply to a probe.
+type serverHello struct {
+	version     uint16 // Negotiated protocol version
+	cipherSuite uint16 // Chosen cipher suite
+	group       uint16 // Key share group chosen under TLS 1.3, if any
+}
+
+// probeHello sends a ClientHello pinned to version and offering only suites,
+// and reads the reply up to the ServerHello without completing the handshake.
+// This lets versions and cipher suites that crypto/tls won't speak, such as
+// SSL 3.0 or export ciphers, be tested. A server that picks another version
+// counts as rejecting the probe.
+func probeHello(addr string, serverName string, timeout time.Duration, version uint16, suites []uint16) (*serverHello, error) {
+	hello, err := buildClientHello(serverName, version, suites)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+
+	if _, err := conn.Write(hello); err != nil {
+		return nil, err
+	}
+
+	sh, err := readServerHello(conn)
+	if err != nil {
+		return nil, err
+	}
+	if sh.version != version {
+		return nil, errHelloRejected
+	}
+	return sh, nil
+}
+
+// buildClientHello returns a ClientHello record for the probe.
+func buildClientHello(serverName string, version uint16, suites []uint16) ([]byte, error) {
+	random := make([]byte, 32)
+	rand.Read(random)
+
+	// TLS 1.3 is negotiated through supported_versions, the legacy field stays at TLS 1.2.
+	legacyVersion := version
+	if version >= versionTLS13 {
+		legacyVersion = versionTLS12
+	}
+
+	var key *ecdh.PrivateKey
+	var sessionID []byte
+	if version >= versionTLS13 {
+		var err error
+		key, err = ecdh.X25519().GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		sessionID = make([]byte, 32)
+		rand.Read(sessionID)
+	}
+
+	var body cryptobyte.Builder
+	body.AddUint16(legacyVersion)
+	body.AddBytes(random)
+	body.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(sessionID) })
+	body.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		for _, s := range suites {
+			b.AddUint16(s)
+		}
+	})
+	body.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddUint8(0) })
+
+	// SSL 3.0 has no extensions.
+	if version > versionSSL30 {
+		body.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			if serverName != "" && net.ParseIP(serverName) == nil {
+				addExtension(b, extServerName, func(b *cryptobyte.Builder) {
+					b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+						b.AddUint8(0) // host_name
+						b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes([]byte(serverName)) })
+					})
+				})
+			}
+			addExtension(b, extSupportedGroups, func(b *cryptobyte.Builder) {
+				b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+					for _, g := range probeGroups {
+						b.AddUint16(g)
+					}
+				})
+			})
+			addExtension(b, extECPointFormats, func(b *cryptobyte.Builder) {
+				b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddUint8(0) })
+			})
+			addExtension(b, extSignatureAlgs, func(b *cryptobyte.Builder) {
+				b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+					for _, s := range probeSignatureAlgs {
+						b.AddUint16(s)
+					}
+				})
+			})
+			addExtension(b, extRenegotiationInfo, func(b *cryptobyte.Builder) { b.AddUint8(0) })
+			if version >= versionTLS13 {
+				addExtension(b, extSupportedVersions, func(b *cryptobyte.Builder) {
+					b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddUint16(version) })
+				})
+				addExtension(b, extPSKModes, func(b *cryptobyte.Builder) {
+					b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddUint8(1) })
+				})
+				addExtension(b, extKeyShare, func(b *cryptobyte.Builder) {
+					b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+						b.AddUint16(groupX25519)
+						b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(key.PublicKey().Bytes()) })
+					})
+				})
+			}
+		})
+	}
+
+	var record cryptobyte.Builder
+	record.AddUint8(recordHandshake)
+	if version == versionSSL30 {
+		record.AddUint16(versionSSL30)
+	} else {
+		record.AddUint16(versionTLS10)
+	}
+	record.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint8(handshakeClientHello)
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(body.BytesOrPanic()) })
+	})
+	return record.Bytes()
+}
+
+// addExtension adds a ClientHello extension with the given body.
+func addExtension(b *cryptobyte.Builder, ext uint16, body cryptobyte.BuilderContinuation) {
+	b.AddUint16(ext)
+	b.AddUint16LengthPrefixed(body)
+}
+
+// readServerHello reads records until a whole ServerHello has arrived and
+// parses it. An alert or a closed connection means the probe was rejected,
+// and an alert is returned as an alertError.
+func readServerHello(conn net.Conn) (*serverHello, error) {
+	var handshake []byte
+
+	for i := 0; i < maxServerHelloRecords; i++ {
+		header := make([]byte, 5)
+		if _, err := io.ReadFull(conn, header); err != nil {
+			if isConnectionClosed(err) {
+				return nil, errHelloRejected
+			}
+			return nil, err
+		}
+
+		payload := make([]byte, int(header[3])<<8|int(header[4]))
+		if _, err := io.ReadFull(conn, payload); err != nil {
+			if isConnectionClosed(err) {
+				return nil, errHelloRejected
+			}
+			return nil, err
+		}
+
+		switch header[0] {
+		case recordAlert:
+			if len(payload) >= 2 {
+				return nil, alertError(payload[1])
+			}
+			return nil, errHelloRejected
+		case recordHandshake:
+			handshake = append(handshake, payload...)
+		default:
+			return nil, errors.New("probe: unexpected record type in reply")
+		}
+
+		if len(handshake) < 4 {
+			continue
+		}
+		if handshake[0] != handshakeServerHello {
+			return nil, errors.New("probe: unexpected handshake message in reply")
+		}
+		length := int(handshake[1])<<16 | int(handshake[2])<<8 | int(handshake[3])
+		if len(handshake) >= 4+length {
+			return parseServerHello(handshake[4 : 4+length])
+		}
+	}
+	return nil, errors.New("probe: ServerHello too long")
+}
+
+// parseServerHello parses the body of a ServerHello.
+func parseServerHello(body []byte) (*serverHello, error) {
+	var sh serverHello
+	var sessionID, extensions cryptobyte.String
+	var compression uint8
+
+	s := cryptobyte.String(body)
+	if !s.ReadUint16(&sh.version) ||
+		!s.Skip(32) ||
+		!s.ReadUint8LengthPrefixed(&sessionID) ||
+		!s.ReadUint16(&sh.cipherSuite) ||
+		!s.ReadUint8(&compression) {
+		return nil, errors.New("probe: malformed ServerHello")
+	}
+
+	if s.Empty() {
+		return &sh, nil
+	}
+	if !s.ReadUint16LengthPrefixed(&extensions) {
+		return nil, errors.New("probe: malformed ServerHello extensions")
+	}
+
+	for !extensions.Empty() {
+		var ext uint16
+		var data cryptobyte.String
+		if !extensions.ReadUint16(&ext) || !extensions.ReadUint16LengthPrefixed(&data) {
+			return nil, errors.New("probe: malformed ServerHello extensions")
+		}
+		switch ext {
+		case extSupportedVersions:
+			if !data.ReadUint16(&sh.version) {
+				return nil, errors.New("probe: malformed supported_versions")
+			}
+		case extKeyShare:
+			// A HelloRetryRequest carries just the group, a ServerHello the group and key.
+			if !data.ReadUint16(&sh.group) {
+				return nil, errors.New("probe: malformed key_share")
+			}
+		}
+	}
+	return &sh, nil
+}
+
+// isConnectionClosed reports whether err is the server dropping the connection.
+func isConnectionClosed(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "read" && !opErr.Timeout()
+}
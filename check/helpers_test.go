@@ -0,0 +1,157 @@
+package check
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCA is a certificate authority that issues the certificates of the test
+// servers.
+type testCA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+	pool *x509.CertPool
+}
+
+// newECDSAKey returns a new P-256 key.
+func newECDSAKey(t *testing.T) crypto.Signer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// newTestCA returns a self-signed CA with key.
+func newTestCA(t *testing.T, key crypto.Signer) *testCA {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root CA", Organization: []string{"check_https_go"}},
+		NotBefore:             time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2124, 1, 1, 0, 0, 0, 0, time.UTC),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{cert: cert, key: key, pool: pool}
+}
+
+// leafTemplate returns a server certificate template for 127.0.0.1 and
+// localhost, which issue fills in.
+func leafTemplate(serial int64) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2124, 1, 1, 0, 0, 0, 0, time.UTC),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+}
+
+// issue signs template for key and returns it as a TLS certificate served
+// with the CA certificate.
+func (ca *testCA) issue(t *testing.T, key crypto.Signer, template *x509.Certificate) tls.Certificate {
+	t.Helper()
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der, ca.cert.Raw}, PrivateKey: key, Leaf: leaf}
+}
+
+// intermediate returns a CA under ca valid until notAfter, whose issue
+// serves its certificates with the intermediate.
+func (ca *testCA) intermediate(t *testing.T, serial int64, notAfter time.Time) *testCA {
+	t.Helper()
+	key := newECDSAKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "Test Intermediate CA", Organization: []string{"check_https_go"}},
+		NotBefore:             time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pool: ca.pool}
+}
+
+// metrics returns the perfdata values of h by label.
+func metrics(h *HTTPCheck) map[string]float64 {
+	values := map[string]float64{}
+	for _, m := range h.PerfData.Metrics() {
+		values[m.Label] = m.Value
+	}
+	return values
+}
+
+// newTLSServer starts an HTTPS server with config, offering HTTP/2 if h2 is
+// set, that is closed when the test ends. Handshake errors, which the
+// protocol and cipher scans cause on purpose, aren't logged.
+func newTLSServer(t *testing.T, config *tls.Config, h2 bool, handler http.Handler) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(handler)
+	srv.EnableHTTP2 = h2
+	srv.TLS = config
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// fetchTest fetches srv with the CA trusted and fails the test if it can't.
+func fetchTest(t *testing.T, srv *httptest.Server, ca *testCA) *HTTPCheck {
+	t.Helper()
+	h := &HTTPCheck{URL: strings.TrimPrefix(srv.URL, "https://"), RootCAs: ca.pool}
+	if r := h.Fetch(0, "check_https_go test", 5); r.Error != nil || r.ReturnCode != 0 {
+		t.Fatalf("Fetch: %v %s", r.Error, r.Value)
+	}
+	return h
+}
+
+// okHandler answers every request with a short page.
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write([]byte("hello from the test server\n"))
+})
@@ -1,52 +1,53 @@
 package check
 
 import (
+	"crypto/rand"
+	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"errors"
 	"io/ioutil"
+	"math"
 	"net/http"
+	"net/http/httptrace"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/jeffalyanak/check_https_go/tlsmap"
+	"golang.org/x/crypto/ocsp"
 )
 
 // HTTPCheck value
 type HTTPCheck struct {
-	URL string
+	URL          string
+	RootCAs      *x509.CertPool    // Roots for chain verification, system roots if nil
+	Certificates []tls.Certificate // Client certificates for mutual TLS
+	Expect       Expectations      // Assertions for CheckExpectations
+	PerfData     PerfData
+	TraceID      [16]byte // Sent in a traceparent header with each request by Fetch when set
+
+	chains              [][]*x509.Certificate // Chains built by CheckChain
+	clientCertRequested bool                  // Server sent a certificate request
+	ocspResponse        *ocsp.Response        // Response parsed by CheckOCSP
+	timeout             time.Duration         // Timeout given to Fetch, reused by later lookups
+	redirects           int                   // Redirect limit given to Fetch
+	userAgent           string                // User agent given to Fetch
+	httpProtocols       *http.Protocols       // HTTP versions Fetch may use, HTTP/1.1 and HTTP/2 if nil
+	protocols           map[uint16]bool       // Versions accepted, found by CheckProtocols
+	protocolRejections  map[uint16]error      // Alerts sent for refused versions
+	cipherSuites        map[uint16][]uint16   // Suites accepted per version, found by CheckCipherSuites
+	resp                *http.Response        // Final response kept by Fetch
+	body                []byte                // Body of the final response
+	redirectInfo        string                // Log of redirects followed by Fetch
+	traces              []*requestTrace       // Phase times of each request made by Fetch
 }
 
-// PerfData holds the Icinga/Nagios format Performance Data
-type PerfData struct {
-	timer  time.Time
-	String string
-}
-
-// StartTimer adds a new key/value Performance Data metric
-func (p *PerfData) StartTimer(t time.Time) {
-	p.timer = t
-}
-
-// Add adds a new key/value Performance Data metric
-func (p *PerfData) Add(key string, value string, uom string) {
-	if p.String == "" {
-		p.String += "|"
-	}
-	p.String += key + "=" + value + uom + " "
-}
-
-// Get returns all the key/value Performance Data metrics
-// Stops the timer and adds that to the key/value pairs.
-func (p *PerfData) Get() string {
-	duration := time.Since(p.timer)
-	durationstr := strconv.Itoa(int(duration.Milliseconds()))
-
-	if p.String == "" {
-		p.String += "|"
-	}
-	return p.String + "checks_took" + "=" + durationstr + "ms"
+// traceparent formats a W3C Trace Context header for a sampled request made
+// as span spanID of trace traceID.
+func traceparent(traceID [16]byte, spanID [8]byte) string {
+	return "00-" + hex.EncodeToString(traceID[:]) + "-" + hex.EncodeToString(spanID[:]) + "-01"
 }
 
 // parseStatusCodes takes a comma-seperated string of HTTP status codes
@@ -68,18 +69,45 @@ func parseStatusCodes(userStatusCodes string) ([]int, error) {
 	return result, nil
 }
 
-// CheckStatus function runs a check of the HTTP status code and returns the result.
-func (h *HTTPCheck) CheckStatus(redirects int, userAgent string, timeoutduration int, userStatusCodes string) Result {
-	var resp *http.Response
+// errNotFetched is returned by the checks when Fetch has not produced a response.
+var errNotFetched = errors.New("check: no response, Fetch must succeed before running checks")
+
+// Fetch requests the URL once, following up to the given number of redirects,
+// and keeps the final response and its body for the status, content and
+// certificate checks.
+func (h *HTTPCheck) Fetch(redirects int, userAgent string, timeoutduration int) Result {
 	var r Result
 	var url string = "https://" + h.URL
-	var statusCodes = []int{200, 201, 202, 203, 204, 205, 206, 207, 208, 226}
 
-	// Create request for domain with a User-Agent header.
-	tr := &http.Transport{}
+	h.resp = nil
+	h.body = nil
+	h.redirectInfo = ""
+	h.traces = nil
+	h.clientCertRequested = false
+	h.chains = nil
+	h.ocspResponse = nil
+	h.protocols = nil
+	h.protocolRejections = nil
+	h.cipherSuites = nil
+	h.timeout = time.Duration(timeoutduration) * time.Second
+	h.redirects = redirects
+	h.userAgent = userAgent
+
+	// Create request for domain with a User-Agent header. Certificate
+	// verification is left to CheckChain so that an untrusted chain is
+	// reported with its reason rather than failing the request. HTTP/2 is
+	// offered over ALPN alongside HTTP/1.1 unless httpProtocols says otherwise.
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify:   true,
+			GetClientCertificate: h.getClientCertificate,
+		},
+		ForceAttemptHTTP2: true,
+		Protocols:         h.httpProtocols,
+	}
 	client := &http.Client{
 		Transport: tr,
-		Timeout:   time.Duration(timeoutduration) * time.Second,
+		Timeout:   h.timeout,
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			return http.ErrUseLastResponse
 
@@ -87,7 +115,8 @@ func (h *HTTPCheck) CheckStatus(redirects int, userAgent string, timeoutduration
 	}
 
 	// Follow redirects up to redirect limit
-	for i := 0; i < redirects; i++ {
+	var resp *http.Response
+	for i := 0; ; i++ {
 		req, err := http.NewRequest("GET", url, nil)
 		if err != nil {
 			r.Error = err
@@ -95,45 +124,107 @@ func (h *HTTPCheck) CheckStatus(redirects int, userAgent string, timeoutduration
 		}
 
 		req.Header.Set("User-Agent", userAgent)
+		trace := &requestTrace{url: url, start: time.Now()}
+		if h.TraceID != [16]byte{} {
+			rand.Read(trace.spanID[:])
+			req.Header.Set("traceparent", traceparent(h.TraceID, trace.spanID))
+		}
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace.clientTrace()))
+		h.traces = append(h.traces, trace)
+
 		resp, err = client.Do(req)
+		if err == nil {
+			trace.status = resp.StatusCode
+		}
+		if err != nil && h.isClientCertRejected(err) {
+			r.ReturnCode = 2
+			r.Value = h.clientCertRejection(err)
+			r.VerboseValue = h.redirectInfo
+			return r
+		}
+		if err != nil && isRemoteAlert(err) {
+			r.Error = errors.New("TLS handshake failed, server sent " + remoteAlert(err) + " alert")
+			r.VerboseValue = h.redirectInfo
+			return r
+		}
 		if err != nil {
 			r.Error = err
+			r.VerboseValue = h.redirectInfo
 			return r
 		}
 
-		if resp.StatusCode == 301 || resp.StatusCode == 302 || resp.StatusCode == 307 {
-			l := resp.Header.Get("Location")
-			r.VerboseValue += url + " redirected (" + resp.Status + ") to " + l + "\n"
-
-			// If the location is relative to the domain
-			if !strings.HasPrefix(l, "http") {
-				// If the new location is relative to the old location, simply add it
-				// to the end of the previous location.
-				if !strings.HasPrefix(l, "/") {
-					l = url + "/" + l
-					// Otherwise—if the new location is relative to the webroot—extract the
-					// root from the old location and then add the new location to it.
-					// Error/break if unable to parse the URL from the old location.
+		if i >= redirects || (resp.StatusCode != 301 && resp.StatusCode != 302 && resp.StatusCode != 307) {
+			break
+		}
+
+		l := resp.Header.Get("Location")
+		h.redirectInfo += url + " redirected (" + resp.Status + ") to " + l + "\n"
+
+		// Only the final response is kept, so drain and close this one
+		// to let the connection be reused for the next hop.
+		ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		trace.done = time.Now()
+
+		// If the location is relative to the domain
+		if !strings.HasPrefix(l, "http") {
+			// If the new location is relative to the old location, simply add it
+			// to the end of the previous location.
+			if !strings.HasPrefix(l, "/") {
+				l = url + "/" + l
+				// Otherwise—if the new location is relative to the webroot—extract the
+				// root from the old location and then add the new location to it.
+				// Error if unable to parse the URL from the old location.
+			} else {
+				re, _ := regexp.Compile("https?://[0-9a-z-.]+(:[0-9]+)?")
+				found := re.FindAllString(url, -1)
+
+				if len(found) > 0 {
+					l = found[0] + l
 				} else {
-					re, _ := regexp.Compile("https?://[0-9a-z-.]+")
-					found := re.FindAllString(url, -1)
-
-					if len(found) > 0 {
-						l = found[0] + l
-					} else {
-						r.Error = errors.New("check http status: could not parse a valid URL")
-						break
-					}
+					r.Error = errors.New("check http status: could not parse a valid URL")
+					r.VerboseValue = h.redirectInfo
+					return r
 				}
 			}
-			url = l
-		} else {
-			break
 		}
+		url = l
 	}
+	defer resp.Body.Close()
 
+	// Body is read here so the client timeout also covers the transfer.
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		r.Error = err
+		r.VerboseValue = h.redirectInfo
+		return r
+	}
+	h.traces[len(h.traces)-1].done = time.Now()
+
+	h.resp = resp
+	h.body = body
+
+	r.URL = url
 	r.Status = resp.StatusCode
-	r.Value = http.StatusText(resp.StatusCode)
+	r.Value = resp.Status
+	r.VerboseValue = h.redirectInfo
+
+	return r
+}
+
+// CheckStatus function checks the HTTP status code of the fetched response and returns the result.
+func (h *HTTPCheck) CheckStatus(userStatusCodes string) Result {
+	var r Result
+	var statusCodes = []int{200, 201, 202, 203, 204, 205, 206, 207, 208, 226}
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	r.Status = h.resp.StatusCode
+	r.Value = http.StatusText(h.resp.StatusCode)
+	r.VerboseValue = h.redirectInfo
 
 	if userStatusCodes != "" {
 		parsedStatusCodes, err := parseStatusCodes(userStatusCodes)
@@ -152,7 +243,7 @@ func (h *HTTPCheck) CheckStatus(redirects int, userAgent string, timeoutduration
 		}
 	}
 
-	// Return code is 0 (OK) for any 2xx status code.
+	// Return code is 0 (OK) for any expected status code.
 	if statusCodeGood {
 		r.ReturnCode = 0
 
@@ -163,24 +254,19 @@ func (h *HTTPCheck) CheckStatus(redirects int, userAgent string, timeoutduration
 	return r
 }
 
-// CheckContent function runs a check of returned body content and returns the result.
+// CheckContent function checks that the body of the fetched response contains
+// checkString and returns the result.
 func (h *HTTPCheck) CheckContent(checkString string) Result {
 	var r Result
 
-	resp, err := http.Get("https://" + h.URL)
-	if err != nil {
-		r.Error = err
-		return r
-	}
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		r.Error = err
+	if h.resp == nil {
+		r.Error = errNotFetched
 		return r
 	}
 
-	if string(body) != "" {
-		if strings.ContainsAny(string(body), checkString) {
+	body := string(h.body)
+	if body != "" {
+		if strings.Contains(body, checkString) {
 			r.ReturnCode = 0
 			r.Value = "Expected content returned: " + checkString
 		} else {
@@ -192,51 +278,106 @@ func (h *HTTPCheck) CheckContent(checkString string) Result {
 		r.Value = "No content returned"
 	}
 
-	// Verbose output includes the first five lines of the body
-	lines := strings.Split(string(body), "\n")
+	lines := strings.Split(body, "\n")
 
 	r.VerboseValue = "Returned " + strconv.Itoa(len(lines)) + " lines of content.\n"
 
 	return r
 }
 
-// CheckCertificate function runs a check of TLS certificate and returns the result.
-func (h *HTTPCheck) CheckCertificate(warn int, crit int) Result {
+// CheckCertificate function checks the expiry of every certificate in the
+// served and verified chains against the warn and crit ranges of days left,
+// judged by the one that expires first, and returns the result.
+func (h *HTTPCheck) CheckCertificate(days Thresholds) Result {
 	var r Result
-	var c *x509.Certificate
 
-	resp, err := http.Get("https://" + h.URL)
-	if err != nil {
-		r.Error = err
+	if h.resp == nil {
+		r.Error = errNotFetched
 		return r
 	}
 
-	certs := resp.TLS.PeerCertificates
-	if len(certs) > 0 {
-		c = certs[0]
-	} else {
+	if h.resp.TLS == nil {
+		r.Error = errors.New("TLS error: response was not sent over TLS")
+		return r
+	}
+
+	certs := h.certificates()
+	if len(certs) == 0 {
 		r.Error = errors.New("TLS error: no certificates returned")
 		return r
 	}
 
-	warndate := time.Now().AddDate(0, 0, warn)
-	critdate := time.Now().AddDate(0, 0, crit)
+	now := time.Now()
+	first := 0
+	r.VerboseValue += "Certificates:\n"
+	for i, c := range certs {
+		if c.NotAfter.Before(certs[first].NotAfter) {
+			first = i
+		}
 
-	if critdate.After(c.NotAfter) {
-		r.ReturnCode = 2
+		daysLeft := math.Floor(c.NotAfter.Sub(now).Hours() / 24)
+		if err := h.PerfData.AddMetric(Metric{Label: "days_left_" + strconv.Itoa(i), Value: daysLeft, Warn: days.Warn, Crit: days.Crit}); err != nil {
+			r.Error = err
+			return r
+		}
+		r.VerboseValue += "  " + strconv.Itoa(i) + ": " + describeCertificate(c) + "\n"
+	}
+	c := certs[first]
+
+	// Fractional days are compared so that a threshold of N days alerts as
+	// soon as less than N days are left.
+	left := c.NotAfter.Sub(now).Hours() / 24
+	r.ReturnCode = days.Evaluate(left)
+	switch r.ReturnCode {
+	case 2:
 		r.Value = "Cert critical"
-	} else if warndate.After(c.NotAfter) {
-		r.ReturnCode = 1
+	case 1:
 		r.Value = "Cert warning"
-	} else {
-		r.ReturnCode = 0
+	default:
 		r.Value = "Cert okay"
 	}
-	r.Value = r.Value + ", valid until " + c.NotAfter.Format("January 02, 2006 15:04")
+	r.Value = r.Value + ", first to expire is #" + strconv.Itoa(first) + " " + describeCertificate(c)
 
 	// Verbose info on TLS version and cipher suite
-	r.VerboseValue += "TLS Version used:  " + tlsmap.TLSVersion(resp.TLS.Version) + "\n"
-	r.VerboseValue += "Cipher suite used: " + tlsmap.CipherSuite(resp.TLS.CipherSuite) + "\n"
+	r.VerboseValue += "TLS Version used:  " + tlsmap.TLSVersion(h.resp.TLS.Version) + "\n"
+	r.VerboseValue += "Cipher suite used: " + tlsmap.CipherSuite(h.resp.TLS.CipherSuite) + "\n"
+	if h.resp.TLS.CurveID != 0 {
+		r.VerboseValue += "Key exchange used: " + tlsmap.Group(uint16(h.resp.TLS.CurveID)) + "\n"
+	}
+	if h.resp.TLS.NegotiatedProtocol != "" {
+		r.VerboseValue += "ALPN protocol:     " + tlsmap.ALPN(h.resp.TLS.NegotiatedProtocol) + "\n"
+	}
 
 	return r
 }
+
+// certificates returns the certificates served by the host, in the order they
+// were sent, followed by any certificates of the verified chain that were not
+// served, such as the root.
+func (h *HTTPCheck) certificates() []*x509.Certificate {
+	if h.resp == nil || h.resp.TLS == nil {
+		return nil
+	}
+
+	certs := append([]*x509.Certificate{}, h.resp.TLS.PeerCertificates...)
+	if len(h.chains) > 0 {
+	chain:
+		for _, c := range h.chains[0] {
+			for _, served := range certs {
+				if c.Equal(served) {
+					continue chain
+				}
+			}
+			certs = append(certs, c)
+		}
+	}
+	return certs
+}
+
+// describeCertificate returns the subject, issuer, serial and expiry of a certificate.
+func describeCertificate(c *x509.Certificate) string {
+	return "subject " + c.Subject.String() +
+		", issuer " + c.Issuer.String() +
+		", serial " + c.SerialNumber.Text(16) +
+		", valid until " + c.NotAfter.Format("January 02, 2006 15:04")
+}
@@ -0,0 +1,274 @@
+package check
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+)
+
+// altService is one alternative service advertised in an Alt-Svc header
+type altService struct {
+	protocol string
+	host     string // Empty when the service is on the origin host
+	port     string
+	maxAge   int // Seconds the advertisement is fresh for
+}
+
+// CheckHTTP3 function looks for an HTTP/3 endpoint in the Alt-Svc header of
+// the fetched response, requests the same URL from it over QUIC and runs the
+// status, content, chain and certificate checks on that response. The time
+// taken is compared against a fresh request over TCP. An endpoint that isn't
+// advertised, or whose advertisement has expired, is a warning, and one that
+// can't be reached is critical.
+func (h *HTTPCheck) CheckHTTP3(userStatusCodes string, checkString string, days Thresholds) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	header := h.resp.Header.Get("Alt-Svc")
+	services, err := parseAltSvc(header)
+	if err != nil {
+		r.Error = err
+		return r
+	}
+	r.VerboseValue = "Alt-Svc: " + header + "\n"
+
+	var service *altService
+	for i := range services {
+		if services[i].protocol == "h3" {
+			service = &services[i]
+			break
+		}
+	}
+	if service == nil {
+		if err := h.PerfData.Add("h3_ok", 0, ""); err != nil {
+			r.Error = err
+			return r
+		}
+		r.ReturnCode = 1
+		r.Value = "HTTP/3 not advertised"
+		return r
+	}
+
+	origin := h.resp.Request.URL
+	host := service.host
+	if host == "" {
+		host = origin.Hostname()
+	}
+	endpoint := net.JoinHostPort(host, service.port)
+	r.VerboseValue += "HTTP/3 endpoint: " + endpoint + "\n"
+
+	start := time.Now()
+	resp, body, err := h.fetchHTTP3(origin, endpoint)
+	h3Took := time.Since(start)
+	if err != nil {
+		if err := h.PerfData.Add("h3_ok", 0, ""); err != nil {
+			r.Error = err
+			return r
+		}
+		r.ReturnCode = 2
+		r.Value = "HTTP/3 failed, " + endpoint + ": " + err.Error()
+		return r
+	}
+
+	// Time a single request over TCP to the same URL for comparison, as the
+	// original fetch may have followed redirects.
+	tcp := &HTTPCheck{URL: strings.TrimPrefix(origin.String(), "https://"), RootCAs: h.RootCAs, Certificates: h.Certificates, TraceID: h.TraceID}
+	start = time.Now()
+	tcpResult := tcp.Fetch(0, h.userAgent, int(h.timeout.Seconds()))
+	tcpTook := time.Since(start)
+
+	// Evaluate the HTTP/3 response with the same checks as the TCP one.
+	h3 := &HTTPCheck{URL: h.URL, RootCAs: h.RootCAs, Certificates: h.Certificates, TraceID: h.TraceID, resp: resp, body: body}
+	results := []struct {
+		rule   string
+		result Result
+	}{
+		{"status", h3.CheckStatus(userStatusCodes)},
+		{"content", h3.CheckContent(checkString)},
+		{"chain", h3.CheckChain()},
+		{"certificate", h3.CheckCertificate(days)},
+	}
+	for _, c := range results {
+		if c.result.Error != nil {
+			r.Error = errors.New("HTTP/3 " + c.rule + ": " + c.result.Error.Error())
+			return r
+		}
+		if c.result.ReturnCode != 0 {
+			r.Findings = append(r.Findings, Finding{Rule: c.rule, ReturnCode: c.result.ReturnCode, Message: "HTTP/3 " + c.rule + ": " + c.result.Value})
+		}
+	}
+
+	ok := 1.0
+	if len(r.Findings) > 0 {
+		ok = 0
+	}
+
+	// The advertisement is fresh for max-age seconds from when the response
+	// was generated, which a cache reports in the Age header.
+	age, _ := strconv.Atoi(h.resp.Header.Get("Age"))
+	r.VerboseValue += "Alt-Svc max-age " + strconv.Itoa(service.maxAge) + "s, response age " + strconv.Itoa(age) + "s\n"
+	if age >= service.maxAge {
+		r.Findings = append(r.Findings, Finding{Rule: "alt-svc", ReturnCode: 1, Message: "Alt-Svc advertisement expired, max-age " + strconv.Itoa(service.maxAge) + "s but the response is " + strconv.Itoa(age) + "s old"})
+	}
+
+	for _, f := range r.Findings {
+		r.ReturnCode = WorstReturnCode(r.ReturnCode, f.ReturnCode)
+		r.VerboseValue += "Finding: " + f.Message + "\n"
+	}
+
+	if err := h.PerfData.Add("h3_ok", ok, ""); err != nil {
+		r.Error = err
+		return r
+	}
+	if err := h.PerfData.Add("h3_time", float64(h3Took.Milliseconds()), "ms"); err != nil {
+		r.Error = err
+		return r
+	}
+
+	timing := resp.Status + " in " + strconv.FormatInt(h3Took.Milliseconds(), 10) + "ms"
+	if tcpResult.Error == nil {
+		if err := h.PerfData.Add("tcp_time", float64(tcpTook.Milliseconds()), "ms"); err != nil {
+			r.Error = err
+			return r
+		}
+		timing += ", TCP took " + strconv.FormatInt(tcpTook.Milliseconds(), 10) + "ms"
+	}
+
+	switch r.ReturnCode {
+	case 0:
+		r.Value = "HTTP/3 okay via " + endpoint + ", " + timing
+	case 1:
+		r.Value = "HTTP/3 warning via " + endpoint + ", " + r.Findings[0].Message
+	default:
+		r.Value = "HTTP/3 critical via " + endpoint + ", " + r.Findings[0].Message
+	}
+
+	return r
+}
+
+// fetchHTTP3 requests target over QUIC from endpoint, without following
+// redirects, and returns the response with its body read.
+func (h *HTTPCheck) fetchHTTP3(target *url.URL, endpoint string) (*http.Response, []byte, error) {
+	tr := &http3.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify:   true,
+			ServerName:           target.Hostname(),
+			GetClientCertificate: h.getClientCertificate,
+		},
+		QUICConfig: &quic.Config{HandshakeIdleTimeout: h.timeout},
+		Dial: func(ctx context.Context, _ string, tlsCfg *tls.Config, cfg *quic.Config) (*quic.Conn, error) {
+			return quic.DialAddrEarly(ctx, endpoint, tlsCfg, cfg)
+		},
+	}
+	defer tr.Close()
+
+	client := &http.Client{
+		Transport: tr,
+		Timeout:   h.timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	req, err := http.NewRequest("GET", target.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("User-Agent", h.userAgent)
+	if h.TraceID != [16]byte{} {
+		var spanID [8]byte
+		rand.Read(spanID[:])
+		req.Header.Set("traceparent", traceparent(h.TraceID, spanID))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.Request == nil {
+		resp.Request = req
+	}
+
+	return resp, body, nil
+}
+
+// parseAltSvc reads the services advertised in an Alt-Svc header value, like
+// h3=":443"; ma=86400, h3-29="alt.example.com:443". A value of clear, or an
+// empty one, advertises none.
+func parseAltSvc(header string) ([]altService, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "clear" {
+		return nil, nil
+	}
+
+	var services []altService
+	for _, entry := range splitOutsideQuotes(header, ',') {
+		params := splitOutsideQuotes(entry, ';')
+
+		protocol, authority, ok := strings.Cut(strings.TrimSpace(params[0]), "=")
+		if !ok {
+			return nil, errors.New("Alt-Svc: malformed entry " + strconv.Quote(entry))
+		}
+		protocol, err := url.PathUnescape(protocol)
+		if err != nil {
+			return nil, errors.New("Alt-Svc: malformed protocol " + strconv.Quote(protocol))
+		}
+
+		host, port, err := net.SplitHostPort(strings.Trim(authority, `"`))
+		if err != nil {
+			return nil, errors.New("Alt-Svc: malformed authority " + authority)
+		}
+
+		service := altService{protocol: protocol, host: host, port: port, maxAge: 86400}
+		for _, p := range params[1:] {
+			name, value, _ := strings.Cut(strings.TrimSpace(p), "=")
+			if strings.EqualFold(name, "ma") {
+				if maxAge, err := strconv.Atoi(strings.Trim(value, `"`)); err == nil {
+					service.maxAge = maxAge
+				}
+			}
+		}
+		services = append(services, service)
+	}
+	return services, nil
+}
+
+// splitOutsideQuotes splits s at each sep that isn't inside a quoted string.
+func splitOutsideQuotes(s string, sep byte) []string {
+	var parts []string
+	quoted := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quoted:
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case s[i] == sep && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
@@ -0,0 +1,79 @@
+package check
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/quic-go/quic-go/http3"
+)
+
+// newHTTP3Server serves handler over QUIC with cert on a local UDP port,
+// until the test ends, and returns the port.
+func newHTTP3Server(t *testing.T, cert tls.Certificate, handler http.Handler) int {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := &http3.Server{
+		Handler:   handler,
+		TLSConfig: http3.ConfigureTLSConfig(&tls.Config{Certificates: []tls.Certificate{cert}}),
+	}
+	go srv.Serve(conn)
+	t.Cleanup(func() {
+		srv.Close()
+		conn.Close()
+	})
+	return conn.LocalAddr().(*net.UDPAddr).Port
+}
+
+func TestCheckHTTP3(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), leafTemplate(5))
+	port := strconv.Itoa(newHTTP3Server(t, cert, okHandler))
+
+	tests := []struct {
+		name     string
+		altSvc   string
+		age      string
+		wantCode int
+		want     string
+		perfdata string
+	}{
+		{"reachable", `h3=":` + port + `"; ma=3600`, "", 0, "HTTP/3 okay via 127.0.0.1:" + port + ", 200 OK", "h3_ok=1"},
+		{"not advertised", `h2=":443"`, "", 1, "HTTP/3 not advertised", "h3_ok=0"},
+		{"expired", `h3=":` + port + `"; ma=60`, "120", 1, "Alt-Svc advertisement expired, max-age 60s but the response is 120s old", "h3_ok=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, false, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Alt-Svc", tt.altSvc)
+				if tt.age != "" {
+					w.Header().Set("Age", tt.age)
+				}
+				okHandler(w, r)
+			}))
+			h := fetchTest(t, srv, ca)
+
+			r := h.CheckHTTP3("200", "hello", Thresholds{})
+			if r.Error != nil {
+				t.Fatalf("CheckHTTP3: %v", r.Error)
+			}
+			if r.ReturnCode != tt.wantCode || !strings.Contains(r.Value, tt.want) {
+				t.Errorf("CheckHTTP3 = %d %q, want %d containing %q", r.ReturnCode, r.Value, tt.wantCode, tt.want)
+			}
+			if perfdata := h.PerfData.Get(); !strings.Contains(perfdata, tt.perfdata) {
+				t.Errorf("perfdata = %q, want %q", perfdata, tt.perfdata)
+			}
+			if tt.wantCode == 0 && !strings.Contains(h.PerfData.Get(), "tcp_time=") {
+				t.Errorf("perfdata = %q, want tcp_time", h.PerfData.Get())
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package check
+
+import (
+	"crypto/tls"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckCertificate(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	days, err := ParseThresholds("30", "7", ParseDaysRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := func(d int) time.Time { return time.Now().Add(time.Duration(d)*24*time.Hour + time.Hour) }
+
+	tests := []struct {
+		name      string
+		leafDays  int
+		interDays int
+		code      int
+		value     string
+	}{
+		{"leaf in warn range", 20, 365, 1, "Cert warning, first to expire is #0 subject CN=localhost,"},
+		{"intermediate critical", 200, 3, 2, "Cert critical, first to expire is #1 subject CN=Test Intermediate CA,"},
+		{"both okay", 90, 365, 0, "Cert okay, first to expire is #0 subject CN=localhost,"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := leafTemplate(30)
+			template.NotAfter = in(tt.leafDays)
+			cert := ca.intermediate(t, 31, in(tt.interDays)).issue(t, newECDSAKey(t), template)
+			h := fetchTest(t, newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, false, okHandler), ca)
+			if r := h.CheckChain(); r.ReturnCode != 0 {
+				t.Fatalf("CheckChain: %s", r.Value)
+			}
+
+			r := h.CheckCertificate(days)
+			if r.Error != nil {
+				t.Fatalf("CheckCertificate: %v", r.Error)
+			}
+			if r.ReturnCode != tt.code || !strings.HasPrefix(r.Value, tt.value) {
+				t.Errorf("CheckCertificate = %d %q, want %d %q", r.ReturnCode, r.Value, tt.code, tt.value)
+			}
+
+			// The leaf and intermediate are served, the root comes from the
+			// verified chain.
+			got := map[string]Metric{}
+			for _, m := range h.PerfData.Metrics() {
+				got[m.Label] = m
+			}
+			for label, want := range map[string]float64{"days_left_0": float64(tt.leafDays), "days_left_1": float64(tt.interDays)} {
+				m := got[label]
+				if m.Value != want || m.Warn == nil || *m.Warn != *days.Warn || m.Crit == nil || *m.Crit != *days.Crit {
+					t.Errorf("perfdata %s = %+v, want %v with the thresholds %v and %v", label, m, want, days.Warn, days.Crit)
+				}
+			}
+			if _, ok := got["days_left_2"]; !ok {
+				t.Error("perfdata has no days_left_2 for the root")
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package check
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+// oidTLSFeature is the TLS Feature extension (RFC 7633) used for must-staple.
+var oidTLSFeature = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 24}
+
+// tlsFeatureStatusRequest is the status_request TLS extension number.
+const tlsFeatureStatusRequest = 5
+
+// ocspClockSkew allows for clock differences when judging response freshness.
+const ocspClockSkew = 5 * time.Minute
+
+// reverse map of CRL/OCSP revocation reason codes to string
+var revocationReasons = map[int]string{
+	ocsp.Unspecified:          "unspecified",
+	ocsp.KeyCompromise:        "key compromise",
+	ocsp.CACompromise:         "CA compromise",
+	ocsp.AffiliationChanged:   "affiliation changed",
+	ocsp.Superseded:           "superseded",
+	ocsp.CessationOfOperation: "cessation of operation",
+	ocsp.CertificateHold:      "certificate hold",
+	ocsp.RemoveFromCRL:        "remove from CRL",
+	ocsp.PrivilegeWithdrawn:   "privilege withdrawn",
+	ocsp.AACompromise:         "AA compromise",
+}
+
+// CheckOCSP function checks the revocation status of the leaf certificate
+// using the stapled OCSP response and returns the result. With query set the
+// certificate's OCSP responder is asked when nothing was stapled. With
+// mustStaple set a missing staple is critical if the certificate carries the
+// TLS Feature extension.
+func (h *HTTPCheck) CheckOCSP(query bool, mustStaple bool) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if h.resp.TLS == nil || len(h.resp.TLS.PeerCertificates) == 0 {
+		r.Error = errors.New("TLS error: no certificates returned")
+		return r
+	}
+
+	leaf := h.resp.TLS.PeerCertificates[0]
+	issuer := h.issuer()
+	if issuer == nil {
+		r.Error = errors.New("OCSP: issuer of " + leaf.Subject.String() + " not found")
+		return r
+	}
+
+	raw := h.resp.TLS.OCSPResponse
+	source := "stapled"
+
+	if len(raw) == 0 {
+		if mustStaple && hasMustStaple(leaf) {
+			if err := h.PerfData.Add("ocsp_stapled", 0, ""); err != nil {
+				r.Error = err
+				return r
+			}
+			r.ReturnCode = 2
+			r.Value = "OCSP critical, certificate is must-staple but no response was stapled"
+			return r
+		}
+
+		if !query {
+			if err := h.PerfData.Add("ocsp_stapled", 0, ""); err != nil {
+				r.Error = err
+				return r
+			}
+			r.Value = "OCSP not checked, no response was stapled"
+			return r
+		}
+
+		if len(leaf.OCSPServer) == 0 {
+			r.Error = errors.New("OCSP: no response was stapled and the certificate names no responder")
+			return r
+		}
+
+		var err error
+		raw, err = h.queryOCSP(leaf.OCSPServer[0], leaf, issuer)
+		if err != nil {
+			r.Error = err
+			return r
+		}
+		source = "from " + leaf.OCSPServer[0]
+	} else {
+		if err := h.PerfData.Add("ocsp_stapled", 1, ""); err != nil {
+			r.Error = err
+			return r
+		}
+	}
+
+	// Parsing checks the response signature against the issuer, directly or
+	// through a responder certificate it delegated to.
+	resp, err := ocsp.ParseResponseForCert(raw, leaf, issuer)
+	if err != nil {
+		r.ReturnCode = 2
+		r.Value = "OCSP critical, invalid response " + source + ": " + err.Error()
+		return r
+	}
+	h.ocspResponse = resp
+
+	now := time.Now()
+	if err := h.PerfData.Add("ocsp_age", math.Trunc(now.Sub(resp.ThisUpdate).Seconds()), "s"); err != nil {
+		r.Error = err
+		return r
+	}
+	if !resp.NextUpdate.IsZero() {
+		if err := h.PerfData.Add("ocsp_next_update", math.Trunc(resp.NextUpdate.Sub(now).Seconds()), "s"); err != nil {
+			r.Error = err
+			return r
+		}
+	}
+
+	r.VerboseValue = "OCSP response " + source + ", produced " + resp.ProducedAt.Format("January 02, 2006 15:04") +
+		", this update " + resp.ThisUpdate.Format("January 02, 2006 15:04")
+	if !resp.NextUpdate.IsZero() {
+		r.VerboseValue += ", next update " + resp.NextUpdate.Format("January 02, 2006 15:04")
+	}
+	r.VerboseValue += "\n"
+
+	switch resp.Status {
+	case ocsp.Good:
+		r.ReturnCode = 0
+		r.Value = "OCSP okay, good"
+	case ocsp.Revoked:
+		r.ReturnCode = 2
+		r.Value = "OCSP critical, revoked " + resp.RevokedAt.Format("January 02, 2006 15:04") +
+			" (" + revocationReason(resp.RevocationReason) + ")"
+		return r
+	default:
+		r.ReturnCode = 1
+		r.Value = "OCSP warning, status unknown to the responder"
+		return r
+	}
+
+	if resp.ThisUpdate.After(now.Add(ocspClockSkew)) {
+		r.ReturnCode = 1
+		r.Value = "OCSP warning, response is not valid until " + resp.ThisUpdate.Format("January 02, 2006 15:04")
+	} else if !resp.NextUpdate.IsZero() && resp.NextUpdate.Before(now.Add(-ocspClockSkew)) {
+		r.ReturnCode = 1
+		r.Value = "OCSP warning, stale response, next update was due " + resp.NextUpdate.Format("January 02, 2006 15:04")
+	} else if !resp.NextUpdate.IsZero() {
+		r.Value += ", next update in " + resp.NextUpdate.Sub(now).Round(time.Minute).String()
+	}
+	r.Value += " (" + source + ")"
+
+	return r
+}
+
+// queryOCSP sends an OCSP request for leaf to the responder and returns the raw response.
+func (h *HTTPCheck) queryOCSP(server string, leaf *x509.Certificate, issuer *x509.Certificate) ([]byte, error) {
+	req, err := ocsp.CreateRequest(leaf, issuer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Timeout: h.timeout}
+	resp, err := client.Post(server, "application/ocsp-request", bytes.NewReader(req))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("OCSP: responder returned " + resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// issuer returns the certificate that issued the leaf, taken from the verified
+// chain if there is one or else from the served certificates.
+func (h *HTTPCheck) issuer() *x509.Certificate {
+	if len(h.chains) > 0 && len(h.chains[0]) > 1 {
+		return h.chains[0][1]
+	}
+
+	certs := h.resp.TLS.PeerCertificates
+	for _, c := range certs[1:] {
+		if certs[0].CheckSignatureFrom(c) == nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// hasMustStaple reports whether the certificate's TLS Feature extension asks for status_request.
+func hasMustStaple(c *x509.Certificate) bool {
+	for _, ext := range c.Extensions {
+		if !ext.Id.Equal(oidTLSFeature) {
+			continue
+		}
+		var features []int
+		if _, err := asn1.Unmarshal(ext.Value, &features); err != nil {
+			return false
+		}
+		for _, f := range features {
+			if f == tlsFeatureStatusRequest {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// revocationReason returns a string from the map or else just formats the code
+func revocationReason(reason int) string {
+	str, ok := revocationReasons[reason]
+	if !ok {
+		return "reason " + strconv.Itoa(reason)
+	}
+	return str
+}
@@ -0,0 +1,113 @@
+package check
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+// ocspResponse returns a response from the CA for cert with status.
+func (ca *testCA) ocspResponse(t *testing.T, cert *x509.Certificate, status int) []byte {
+	t.Helper()
+	now := time.Now()
+	template := ocsp.Response{
+		Status:       status,
+		SerialNumber: cert.SerialNumber,
+		ThisUpdate:   now.Add(-time.Hour),
+		NextUpdate:   now.Add(24 * time.Hour),
+	}
+	if status == ocsp.Revoked {
+		template.RevokedAt = now.Add(-2 * time.Hour)
+		template.RevocationReason = ocsp.KeyCompromise
+	}
+	raw, err := ocsp.CreateResponse(ca.cert, ca.cert, template, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func TestCheckOCSP(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+
+	// The responder answers for whatever serial it is asked about with the
+	// status of the current test case.
+	var responderStatus int
+	responder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req, err := ocsp.ParseRequest(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		w.Write(ca.ocspResponse(t, &x509.Certificate{SerialNumber: req.SerialNumber}, responderStatus))
+	}))
+	defer responder.Close()
+
+	mustStapleExt, err := asn1.Marshal([]int{tlsFeatureStatusRequest})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		staple          int // Status of the stapled response, or -1 for none
+		certMustStaple  bool
+		query           bool
+		mustStaple      bool
+		responderStatus int
+		wantCode        int
+		want            string
+	}{
+		{name: "good staple", staple: ocsp.Good, wantCode: 0, want: "OCSP okay, good"},
+		{name: "revoked staple", staple: ocsp.Revoked, wantCode: 2, want: "(key compromise)"},
+		{name: "unknown staple", staple: ocsp.Unknown, wantCode: 1, want: "status unknown to the responder"},
+		{name: "must-staple without staple", staple: -1, certMustStaple: true, mustStaple: true, query: true, wantCode: 2, want: "certificate is must-staple but no response was stapled"},
+		{name: "must-staple with staple", staple: ocsp.Good, certMustStaple: true, mustStaple: true, wantCode: 0, want: "(stapled)"},
+		{name: "no staple, not queried", staple: -1, wantCode: 0, want: "OCSP not checked"},
+		{name: "responder good", staple: -1, query: true, responderStatus: ocsp.Good, wantCode: 0, want: "(from " + responder.URL + ")"},
+		{name: "responder revoked", staple: -1, query: true, responderStatus: ocsp.Revoked, wantCode: 2, want: "OCSP critical, revoked"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := leafTemplate(int64(100 + i))
+			template.OCSPServer = []string{responder.URL}
+			if tt.certMustStaple {
+				template.ExtraExtensions = []pkix.Extension{{Id: oidTLSFeature, Value: mustStapleExt}}
+			}
+			cert := ca.issue(t, newECDSAKey(t), template)
+			if tt.staple >= 0 {
+				cert.OCSPStaple = ca.ocspResponse(t, cert.Leaf, tt.staple)
+			}
+			responderStatus = tt.responderStatus
+
+			h := fetchTest(t, newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, false, okHandler), ca)
+			if r := h.CheckChain(); r.ReturnCode != 0 {
+				t.Fatalf("CheckChain: %s", r.Value)
+			}
+
+			r := h.CheckOCSP(tt.query, tt.mustStaple)
+			if r.Error != nil {
+				t.Fatalf("CheckOCSP: %v", r.Error)
+			}
+			if r.ReturnCode != tt.wantCode || !strings.Contains(r.Value, tt.want) {
+				t.Errorf("CheckOCSP = %d %q, want %d containing %q", r.ReturnCode, r.Value, tt.wantCode, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package check
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// perfUnits are the units of measurement allowed by the Nagios plugin
+// guidelines, with an empty unit for plain numbers
+var perfUnits = map[string]bool{
+	"":   true,
+	"s":  true,
+	"ms": true,
+	"us": true,
+	"%":  true,
+	"B":  true,
+	"KB": true,
+	"MB": true,
+	"TB": true,
+	"c":  true,
+}
+
+// Metric is one Performance Data metric. Warn and Crit are nil when the
+// metric has no threshold, and Min and Max are nil when it has no bound.
+type Metric struct {
+	Label   string
+	Value   float64
+	Unknown bool // The value could not be determined, written as U
+	UOM     string
+	Warn    *Range
+	Crit    *Range
+	Min     *float64
+	Max     *float64
+}
+
+// PerfData holds the Icinga/Nagios format Performance Data
+type PerfData struct {
+	timer   time.Time
+	metrics []Metric
+}
+
+// StartTimer sets the time checks_took is measured from
+func (p *PerfData) StartTimer(t time.Time) {
+	p.timer = t
+}
+
+// Add adds a new label/value metric without thresholds, see AddMetric.
+func (p *PerfData) Add(label string, value float64, uom string) error {
+	return p.AddMetric(Metric{Label: label, Value: value, UOM: uom})
+}
+
+// AddMetric adds m after validating it. A metric with the label of one added
+// earlier replaces it in place, so each label appears once, in the order it
+// was first added.
+func (p *PerfData) AddMetric(m Metric) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	for i := range p.metrics {
+		if p.metrics[i].Label == m.Label {
+			p.metrics[i] = m
+			return nil
+		}
+	}
+	p.metrics = append(p.metrics, m)
+	return nil
+}
+
+// Metrics returns the metrics added so far, in order
+func (p *PerfData) Metrics() []Metric {
+	return append([]Metric(nil), p.metrics...)
+}
+
+// Took returns the time since StartTimer
+func (p *PerfData) Took() time.Duration {
+	return time.Since(p.timer)
+}
+
+// Get renders the metrics followed by checks_took, the time since
+// StartTimer, as the perfdata part of the plugin output
+func (p *PerfData) Get() string {
+	took := Metric{Label: "checks_took", Value: float64(p.Took().Milliseconds()), UOM: "ms"}
+
+	parts := make([]string, 0, len(p.metrics)+1)
+	for _, m := range p.metrics {
+		parts = append(parts, m.String())
+	}
+	parts = append(parts, took.String())
+
+	return "|" + strings.Join(parts, " ")
+}
+
+// Validate checks that the metric can be written as perfdata.
+func (m Metric) Validate() error {
+	if m.Label == "" {
+		return errors.New("perfdata: empty label")
+	}
+	if strings.ContainsAny(m.Label, "=|\n") {
+		return errors.New("perfdata: label " + strconv.Quote(m.Label) + " contains =, | or a newline")
+	}
+	if !perfUnits[m.UOM] {
+		return errors.New("perfdata: " + m.Label + ": unit " + strconv.Quote(m.UOM) + " is not one of s, ms, us, %, B, KB, MB, TB or c")
+	}
+	if !m.Unknown && (math.IsNaN(m.Value) || math.IsInf(m.Value, 0)) {
+		return errors.New("perfdata: " + m.Label + ": value is not a finite number")
+	}
+	for _, bound := range []*float64{m.Min, m.Max} {
+		if bound != nil && (math.IsNaN(*bound) || math.IsInf(*bound, 0)) {
+			return errors.New("perfdata: " + m.Label + ": min and max must be finite numbers")
+		}
+	}
+	return nil
+}
+
+// String formats the metric as 'label'=value[UOM];[warn];[crit];[min];[max],
+// leaving out trailing empty fields.
+func (m Metric) String() string {
+	value := "U"
+	if !m.Unknown {
+		value = formatPerfNumber(m.Value) + m.UOM
+	}
+
+	fields := []string{value, "", "", "", ""}
+	if m.Warn != nil {
+		fields[1] = m.Warn.String()
+	}
+	if m.Crit != nil {
+		fields[2] = m.Crit.String()
+	}
+	if m.Min != nil {
+		fields[3] = formatPerfNumber(*m.Min)
+	}
+	if m.Max != nil {
+		fields[4] = formatPerfNumber(*m.Max)
+	}
+
+	return quoteLabel(m.Label) + "=" + strings.TrimRight(strings.Join(fields, ";"), ";")
+}
+
+// quoteLabel wraps a label holding spaces or quotes in single quotes, with
+// any quote inside it doubled.
+func quoteLabel(label string) string {
+	if !strings.ContainsAny(label, " \t'") {
+		return label
+	}
+	return "'" + strings.ReplaceAll(label, "'", "''") + "'"
+}
+
+// ParsePerfData reads perfdata as written by Get, or by any plugin following
+// the Nagios guidelines, with or without the leading |.
+func ParsePerfData(s string) ([]Metric, error) {
+	s = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(s), "|"))
+
+	var metrics []Metric
+	for s != "" {
+		label, rest, err := parseLabel(s)
+		if err != nil {
+			return nil, err
+		}
+
+		end := strings.IndexAny(rest, " \t")
+		if end < 0 {
+			end = len(rest)
+		}
+		m, err := parseMetricFields(label, rest[:end])
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, m)
+		s = strings.TrimLeft(rest[end:], " \t")
+	}
+	return metrics, nil
+}
+
+// parseLabel reads a label and its = from the start of s, and returns the
+// label with the rest of s.
+func parseLabel(s string) (string, string, error) {
+	if !strings.HasPrefix(s, "'") {
+		label, rest, ok := strings.Cut(s, "=")
+		if !ok || label == "" || strings.ContainsAny(label, " \t") {
+			return "", "", errors.New("perfdata: malformed metric at " + strconv.Quote(s))
+		}
+		return label, rest, nil
+	}
+
+	var label strings.Builder
+	for i := 1; i < len(s); i++ {
+		if s[i] != '\'' {
+			label.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '\'' {
+			label.WriteByte('\'')
+			i++
+			continue
+		}
+		if i+1 >= len(s) || s[i+1] != '=' {
+			return "", "", errors.New("perfdata: quoted label not followed by = at " + strconv.Quote(s))
+		}
+		return label.String(), s[i+2:], nil
+	}
+	return "", "", errors.New("perfdata: unterminated quoted label at " + strconv.Quote(s))
+}
+
+// parseMetricFields reads value[UOM];[warn];[crit];[min];[max] for label.
+func parseMetricFields(label string, s string) (Metric, error) {
+	m := Metric{Label: label}
+	fields := strings.Split(s, ";")
+	if len(fields) > 5 {
+		return m, errors.New("perfdata: " + label + ": more than five fields")
+	}
+
+	if fields[0] == "U" {
+		m.Unknown = true
+	} else {
+		n := strings.IndexFunc(fields[0], func(r rune) bool {
+			return !strings.ContainsRune("0123456789.-+", r)
+		})
+		if n < 0 {
+			n = len(fields[0])
+		}
+		v, err := parsePerfNumber(fields[0][:n])
+		if err != nil {
+			return m, errors.New("perfdata: " + label + ": " + err.Error())
+		}
+		m.Value, m.UOM = v, fields[0][n:]
+	}
+
+	for i, target := range []**Range{&m.Warn, &m.Crit} {
+		if len(fields) > i+1 && fields[i+1] != "" {
+			r, err := ParseRange(fields[i+1])
+			if err != nil {
+				return m, errors.New("perfdata: " + label + ": " + err.Error())
+			}
+			*target = &r
+		}
+	}
+
+	for i, target := range []**float64{&m.Min, &m.Max} {
+		if len(fields) > i+3 && fields[i+3] != "" {
+			v, err := parsePerfNumber(fields[i+3])
+			if err != nil {
+				return m, errors.New("perfdata: " + label + ": " + err.Error())
+			}
+			*target = &v
+		}
+	}
+
+	return m, m.Validate()
+}
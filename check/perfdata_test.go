@@ -0,0 +1,85 @@
+package check
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPerfDataRoundTrip(t *testing.T) {
+	above := Range{Start: 10, End: math.Inf(1)}
+	below := Range{Start: math.Inf(-1), End: 20}
+	inside := Range{Start: 5, End: 10, Inside: true}
+	upTo := Range{End: 30}
+	zero, hundred := 0.0, 100.0
+
+	metrics := []Metric{
+		{Label: "plain", Value: 1},
+		{Label: "time_total", Value: 12.345, UOM: "ms", Warn: &above, Crit: &below, Min: &zero},
+		{Label: "ocsp_age", Value: -3, UOM: "s", Warn: &inside},
+		{Label: "handshake", Value: 250, UOM: "us", Crit: &upTo},
+		{Label: "disk used", Value: 42.5, UOM: "%", Warn: &above, Crit: &upTo, Min: &zero, Max: &hundred},
+		{Label: "size", Value: 2048, UOM: "B"},
+		{Label: "it's", Value: 1.5, UOM: "KB"},
+		{Label: "'quoted' label", Value: 3, UOM: "MB"},
+		{Label: "archive", Value: 0.25, UOM: "TB"},
+		{Label: "requests", Value: 7, UOM: "c"},
+		{Label: "unknown", Unknown: true, Warn: &above},
+	}
+
+	var p PerfData
+	for _, m := range metrics {
+		if err := p.AddMetric(m); err != nil {
+			t.Fatalf("AddMetric(%+v): %v", m, err)
+		}
+	}
+
+	rendered := p.Get()
+	for _, want := range []string{
+		"|plain=1 ",
+		" time_total=12.345ms;10:;~:20;0 ",
+		" ocsp_age=-3s;@5:10 ",
+		" handshake=250us;;30 ",
+		" 'disk used'=42.5%;10:;30;0;100 ",
+		" 'it''s'=1.5KB ",
+		" '''quoted'' label'=3MB ",
+		" archive=0.25TB ",
+		" requests=7c ",
+		" unknown=U;10: ",
+		" checks_took=",
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Get() = %q, want it to contain %q", rendered, want)
+		}
+	}
+
+	parsed, err := ParsePerfData(rendered)
+	if err != nil {
+		t.Fatalf("ParsePerfData(%q): %v", rendered, err)
+	}
+	if len(parsed) != len(metrics)+1 || parsed[len(parsed)-1].Label != "checks_took" {
+		t.Fatalf("ParsePerfData(%q) = %+v, want the metrics followed by checks_took", rendered, parsed)
+	}
+	for i, m := range metrics {
+		if !reflect.DeepEqual(parsed[i], m) {
+			t.Errorf("metric %d parsed as %+v, want %+v", i, parsed[i], m)
+		}
+	}
+}
+
+func TestPerfDataAddInvalid(t *testing.T) {
+	var p PerfData
+	if err := p.Add("size", 1, "GB"); err == nil {
+		t.Error(`Add with unit "GB" succeeded, want an error`)
+	}
+	if err := p.Add("a=b", 1, ""); err == nil {
+		t.Error(`Add with label "a=b" succeeded, want an error`)
+	}
+	if err := p.Add("nan", math.NaN(), ""); err == nil {
+		t.Error("Add with a NaN value succeeded, want an error")
+	}
+	if len(p.Metrics()) != 0 {
+		t.Errorf("Metrics() = %+v after invalid adds, want none", p.Metrics())
+	}
+}
@@ -0,0 +1,102 @@
+package check
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ParseCertPins takes a comma-seperated string of SHA-256 certificate
+// fingerprints in hex, with or without colons, and returns them normalised
+// to lower case hex.
+func ParseCertPins(userPins string) ([]string, error) {
+	if userPins == "" {
+		return nil, nil
+	}
+
+	var pins []string
+	for _, pin := range strings.Split(userPins, ",") {
+		pin = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(pin), ":", ""))
+		if b, err := hex.DecodeString(pin); err != nil || len(b) != sha256.Size {
+			return nil, errors.New("certificate pin: not a SHA-256 fingerprint: " + pin)
+		}
+		pins = append(pins, pin)
+	}
+	return pins, nil
+}
+
+// ParseSPKIPins takes a comma-seperated string of HPKP-style pins, the base64
+// SHA-256 hash of a SubjectPublicKeyInfo, optionally prefixed with "sha256/".
+func ParseSPKIPins(userPins string) ([]string, error) {
+	if userPins == "" {
+		return nil, nil
+	}
+
+	var pins []string
+	for _, pin := range strings.Split(userPins, ",") {
+		pin = strings.TrimPrefix(strings.TrimSpace(pin), "sha256/")
+		if b, err := base64.StdEncoding.DecodeString(pin); err != nil || len(b) != sha256.Size {
+			return nil, errors.New("public key pin: not a base64 SHA-256 hash: " + pin)
+		}
+		pins = append(pins, pin)
+	}
+	return pins, nil
+}
+
+// CertFingerprint returns the hex SHA-256 fingerprint of a certificate.
+func CertFingerprint(c *x509.Certificate) string {
+	sum := sha256.Sum256(c.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// SPKIPin returns the base64 SHA-256 hash of a certificate's SubjectPublicKeyInfo.
+func SPKIPin(c *x509.Certificate) string {
+	sum := sha256.Sum256(c.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// CheckPins function checks the leaf and chain certificates against the
+// certificate and public key pins and returns the result. It passes when any
+// certificate matches any pin and is critical otherwise.
+func (h *HTTPCheck) CheckPins(certPins []string, spkiPins []string) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	certs := h.certificates()
+	if len(certs) == 0 {
+		r.Error = errors.New("TLS error: no certificates returned")
+		return r
+	}
+
+	r.VerboseValue = "Observed fingerprints:\n"
+	for i, c := range certs {
+		fingerprint := CertFingerprint(c)
+		spki := SPKIPin(c)
+		r.VerboseValue += "  " + strconv.Itoa(i) + ": sha256 " + fingerprint + ", spki sha256/" + spki + "\n"
+
+		for _, pin := range certPins {
+			if pin == fingerprint {
+				r.Value = "Pin okay, certificate #" + strconv.Itoa(i) + " matches " + pin
+				return r
+			}
+		}
+		for _, pin := range spkiPins {
+			if pin == spki {
+				r.Value = "Pin okay, public key of certificate #" + strconv.Itoa(i) + " matches sha256/" + pin
+				return r
+			}
+		}
+	}
+
+	r.ReturnCode = 2
+	r.Value = "Pin critical, no certificate matches, leaf is sha256 " + CertFingerprint(certs[0]) + ", spki sha256/" + SPKIPin(certs[0])
+	return r
+}
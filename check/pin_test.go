@@ -0,0 +1,66 @@
+package check
+
+import (
+	"crypto/tls"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePins(t *testing.T) {
+	fingerprint := strings.Repeat("ab", 32)
+	colons := strings.ToUpper(strings.TrimSuffix(strings.Repeat("AB:", 32), ":"))
+	spki := "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="
+
+	certPins, err := ParseCertPins(" " + colons + "," + fingerprint)
+	if err != nil || len(certPins) != 2 || certPins[0] != fingerprint || certPins[1] != fingerprint {
+		t.Errorf("ParseCertPins = %q, %v, want %s twice", certPins, err, fingerprint)
+	}
+	spkiPins, err := ParseSPKIPins("sha256/" + spki + ", " + spki)
+	if err != nil || len(spkiPins) != 2 || spkiPins[0] != spki || spkiPins[1] != spki {
+		t.Errorf("ParseSPKIPins = %q, %v, want %s twice", spkiPins, err, spki)
+	}
+	if pins, err := ParseCertPins(""); pins != nil || err != nil {
+		t.Errorf("ParseCertPins of nothing = %q, %v, want no pins", pins, err)
+	}
+
+	for _, pin := range []string{"ab", fingerprint + "ab", strings.Repeat("zz", 32), fingerprint + ",sha256/" + spki} {
+		if _, err := ParseCertPins(pin); err == nil {
+			t.Errorf("ParseCertPins(%q) succeeded, want an error", pin)
+		}
+	}
+	for _, pin := range []string{"sha256/", "sha1/" + spki, spki[:40], fingerprint} {
+		if _, err := ParseSPKIPins(pin); err == nil {
+			t.Errorf("ParseSPKIPins(%q) succeeded, want an error", pin)
+		}
+	}
+}
+
+func TestCheckPins(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	intermediate := ca.intermediate(t, 50, time.Date(2124, 1, 1, 0, 0, 0, 0, time.UTC))
+	cert := intermediate.issue(t, newECDSAKey(t), leafTemplate(51))
+	other := ca.issue(t, newECDSAKey(t), leafTemplate(52))
+	h := fetchTest(t, newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, false, okHandler), ca)
+
+	tests := []struct {
+		name     string
+		certPins []string
+		spkiPins []string
+		code     int
+		value    string
+	}{
+		{"leaf fingerprint", []string{CertFingerprint(other.Leaf), CertFingerprint(cert.Leaf)}, nil, 0, "Pin okay, certificate #0 matches " + CertFingerprint(cert.Leaf)},
+		{"leaf public key", nil, []string{SPKIPin(cert.Leaf)}, 0, "Pin okay, public key of certificate #0 matches sha256/" + SPKIPin(cert.Leaf)},
+		{"intermediate public key", nil, []string{SPKIPin(intermediate.cert)}, 0, "Pin okay, public key of certificate #1 matches sha256/" + SPKIPin(intermediate.cert)},
+		{"intermediate fingerprint", []string{CertFingerprint(intermediate.cert)}, nil, 0, "Pin okay, certificate #1 matches " + CertFingerprint(intermediate.cert)},
+		{"mismatch", []string{CertFingerprint(other.Leaf)}, []string{SPKIPin(other.Leaf)}, 2, "Pin critical, no certificate matches, leaf is sha256 " + CertFingerprint(cert.Leaf) + ", spki sha256/" + SPKIPin(cert.Leaf)},
+	}
+
+	for _, tt := range tests {
+		r := h.CheckPins(tt.certPins, tt.spkiPins)
+		if r.Error != nil || r.ReturnCode != tt.code || r.Value != tt.value {
+			t.Errorf("%s: CheckPins = %d %q, %v, want %d %q", tt.name, r.ReturnCode, r.Value, r.Error, tt.code, tt.value)
+		}
+	}
+}
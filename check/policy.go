@@ -0,0 +1,148 @@
+package check
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Policy holds the hygiene rules CheckPolicy applies to the leaf certificate
+type Policy struct {
+	MinRSABits       int      // Smallest RSA modulus allowed
+	AllowedCurves    []string // EC curves allowed for the key, like P-256 or Ed25519
+	ForbiddenSigAlgs []string // Hashes that must not appear in the signature algorithm, like SHA1
+	MaxValidityDays  int      // Longest validity period allowed, 0 for no limit
+}
+
+// DefaultPolicy returns the rules of the CA/Browser Forum baseline requirements.
+func DefaultPolicy() Policy {
+	return Policy{
+		MinRSABits:       2048,
+		AllowedCurves:    []string{"P-256", "P-384", "P-521"},
+		ForbiddenSigAlgs: []string{"MD2", "MD5", "SHA1"},
+		MaxValidityDays:  398,
+	}
+}
+
+// CheckPolicy function checks the leaf certificate's key, signature
+// algorithm, validity period, SAN coverage of the host and start date against
+// the policy and returns the result. Each rule is a finding of its own and the
+// result takes the worst of them.
+func (h *HTTPCheck) CheckPolicy(p Policy) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if h.resp.TLS == nil || len(h.resp.TLS.PeerCertificates) == 0 {
+		r.Error = errors.New("TLS error: no certificates returned")
+		return r
+	}
+
+	c := h.resp.TLS.PeerCertificates[0]
+	host := h.resp.Request.URL.Hostname()
+	add := func(rule string, code int, msg string) {
+		r.Findings = append(r.Findings, Finding{Rule: rule, ReturnCode: code, Message: msg})
+	}
+
+	// Key size and curve
+	switch k := c.PublicKey.(type) {
+	case *rsa.PublicKey:
+		bits := k.N.BitLen()
+		if bits < p.MinRSABits {
+			add("key", 2, "RSA key of "+strconv.Itoa(bits)+" bits, at least "+strconv.Itoa(p.MinRSABits)+" required")
+		} else {
+			add("key", 0, "RSA key of "+strconv.Itoa(bits)+" bits")
+		}
+	case *ecdsa.PublicKey:
+		add("key", curveFinding(k.Curve.Params().Name, p.AllowedCurves), "EC key on "+k.Curve.Params().Name+curveNote(k.Curve.Params().Name, p.AllowedCurves))
+	case ed25519.PublicKey:
+		add("key", curveFinding("Ed25519", p.AllowedCurves), "EC key on Ed25519"+curveNote("Ed25519", p.AllowedCurves))
+	default:
+		add("key", 2, "unsupported key type "+c.PublicKeyAlgorithm.String())
+	}
+
+	// Signature algorithm
+	sigAlg := c.SignatureAlgorithm.String()
+	forbidden := ""
+	for _, alg := range p.ForbiddenSigAlgs {
+		if alg != "" && strings.Contains(strings.ToUpper(sigAlg), strings.ToUpper(alg)) {
+			forbidden = alg
+			break
+		}
+	}
+	if forbidden != "" {
+		add("signature", 2, "signed with "+sigAlg+", "+forbidden+" is forbidden")
+	} else {
+		add("signature", 0, "signed with "+sigAlg)
+	}
+
+	// Validity period
+	days := int(math.Ceil(c.NotAfter.Sub(c.NotBefore).Hours() / 24))
+	if p.MaxValidityDays > 0 && days > p.MaxValidityDays {
+		add("lifetime", 1, "valid for "+strconv.Itoa(days)+" days, at most "+strconv.Itoa(p.MaxValidityDays)+" allowed")
+	} else {
+		add("lifetime", 0, "valid for "+strconv.Itoa(days)+" days")
+	}
+
+	// SAN coverage of the host
+	if len(c.DNSNames) == 0 && len(c.IPAddresses) == 0 {
+		add("san", 2, "no subject alternative names")
+	} else if err := c.VerifyHostname(host); err != nil {
+		add("san", 2, "subject alternative names do not cover "+host)
+	} else {
+		add("san", 0, "subject alternative names cover "+host)
+	}
+
+	// Start of the validity period
+	if c.NotBefore.After(time.Now()) {
+		add("not_before", 2, "not valid before "+c.NotBefore.Format("January 02, 2006 15:04"))
+	} else {
+		add("not_before", 0, "valid since "+c.NotBefore.Format("January 02, 2006 15:04"))
+	}
+
+	var problems []string
+	for _, f := range r.Findings {
+		r.ReturnCode = WorstReturnCode(r.ReturnCode, f.ReturnCode)
+		if f.ReturnCode != 0 {
+			problems = append(problems, f.Message)
+		}
+		r.VerboseValue += "Policy " + f.Rule + ": " + f.Message + "\n"
+	}
+
+	switch r.ReturnCode {
+	case 0:
+		r.Value = "Policy okay, " + strconv.Itoa(len(r.Findings)) + " rules passed"
+	case 1:
+		r.Value = "Policy warning, " + strings.Join(problems, "; ")
+	default:
+		r.Value = "Policy critical, " + strings.Join(problems, "; ")
+	}
+
+	return r
+}
+
+// curveFinding returns the return code for a key on the named curve.
+func curveFinding(curve string, allowed []string) int {
+	for _, a := range allowed {
+		if strings.EqualFold(a, curve) {
+			return 0
+		}
+	}
+	return 2
+}
+
+// curveNote explains a curve that isn't allowed.
+func curveNote(curve string, allowed []string) string {
+	if curveFinding(curve, allowed) == 0 {
+		return ""
+	}
+	return ", allowed curves are " + strings.Join(allowed, ", ")
+}
@@ -0,0 +1,92 @@
+package check
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckPolicy(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	rsaKey := func(bits int) crypto.Signer {
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+	// Every rule passes for this template under the default policy.
+	compliant := func() *x509.Certificate {
+		template := leafTemplate(60)
+		template.NotBefore = time.Now().Add(-time.Hour)
+		template.NotAfter = template.NotBefore.Add(90 * 24 * time.Hour)
+		return template
+	}
+
+	sha1 := compliant()
+	sha1.SignatureAlgorithm = x509.ECDSAWithSHA1
+	long := compliant()
+	long.NotAfter = long.NotBefore.Add(825 * 24 * time.Hour)
+	noSAN := compliant()
+	noSAN.DNSNames, noSAN.IPAddresses = nil, nil
+	otherSAN := compliant()
+	otherSAN.DNSNames, otherSAN.IPAddresses = []string{"example.com"}, nil
+
+	tests := []struct {
+		name     string
+		key      crypto.Signer
+		template *x509.Certificate
+		code     int
+		rule     string
+		message  string
+	}{
+		{"compliant", newECDSAKey(t), compliant(), 0, "", "Policy okay, 5 rules passed"},
+		{"small RSA key", rsaKey(1024), compliant(), 2, "key", "RSA key of 1024 bits, at least 2048 required"},
+		{"SHA-1 signature", newECDSAKey(t), sha1, 2, "signature", "signed with ECDSA-SHA1, SHA1 is forbidden"},
+		{"long validity", newECDSAKey(t), long, 1, "lifetime", "valid for 825 days, at most 398 allowed"},
+		{"no SAN", newECDSAKey(t), noSAN, 2, "san", "no subject alternative names"},
+		{"SAN not covering the host", newECDSAKey(t), otherSAN, 2, "san", "subject alternative names do not cover 127.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cert := ca.issue(t, tt.key, tt.template)
+			h := fetchTest(t, newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, false, okHandler), ca)
+
+			r := h.CheckPolicy(DefaultPolicy())
+			if r.Error != nil {
+				t.Fatalf("CheckPolicy: %v", r.Error)
+			}
+			if r.ReturnCode != tt.code {
+				t.Errorf("CheckPolicy = %d %q, want %d", r.ReturnCode, r.Value, tt.code)
+			}
+			if len(r.Findings) != 5 {
+				t.Fatalf("CheckPolicy findings = %+v, want one for each of the 5 rules", r.Findings)
+			}
+			if tt.rule == "" {
+				if r.Value != tt.message {
+					t.Errorf("CheckPolicy = %q, want %q", r.Value, tt.message)
+				}
+				return
+			}
+
+			// Only the broken rule fails.
+			for _, f := range r.Findings {
+				switch {
+				case f.Rule == tt.rule && (f.ReturnCode != tt.code || f.Message != tt.message):
+					t.Errorf("finding %s = %d %q, want %d %q", f.Rule, f.ReturnCode, f.Message, tt.code, tt.message)
+				case f.Rule != tt.rule && f.ReturnCode != 0:
+					t.Errorf("finding %s = %d %q, want it to pass", f.Rule, f.ReturnCode, f.Message)
+				}
+			}
+			if !strings.Contains(r.Value, tt.message) {
+				t.Errorf("CheckPolicy = %q, want it to name %q", r.Value, tt.message)
+			}
+		})
+	}
+}
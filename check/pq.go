@@ -0,0 +1,147 @@
+package check
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/jeffalyanak/check_https_go/tlsmap"
+)
+
+// hybridGroups are the hybrid ML-KEM groups tried by CheckPostQuantum
+var hybridGroups = []tls.CurveID{tls.X25519MLKEM768, tls.SecP256r1MLKEM768, tls.SecP384r1MLKEM1024}
+
+// CheckPostQuantum function reports the key exchange group negotiated for the
+// fetched response, tries a TLS 1.3 handshake offering only each hybrid
+// ML-KEM group, and returns the result. A classical group being negotiated is
+// a warning if warnClassical is set.
+func (h *HTTPCheck) CheckPostQuantum(warnClassical bool) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if h.resp.TLS == nil {
+		r.Error = errors.New("TLS error: no connection state")
+		return r
+	}
+
+	negotiated := uint16(h.resp.TLS.CurveID)
+	postQuantum := tlsmap.IsPostQuantumGroup(negotiated)
+
+	kind := "classical"
+	if postQuantum {
+		kind = "post-quantum"
+	}
+	name := tlsmap.Group(negotiated)
+	if negotiated == 0 {
+		name = "none"
+		kind = "RSA key transport"
+	}
+	r.VerboseValue = "Key exchange group negotiated: " + name + " (" + kind + ")\n"
+
+	var accepted []string
+	for _, g := range hybridGroups {
+		err := h.tryGroup(g)
+		if err != nil && !isHandshakeRefused(err) {
+			r.Error = errors.New("post-quantum check: " + tlsmap.Group(uint16(g)) + ": " + err.Error())
+			return r
+		}
+
+		if err != nil {
+			r.VerboseValue += tlsmap.Group(uint16(g)) + ": rejected (" + err.Error() + ")\n"
+			continue
+		}
+		accepted = append(accepted, tlsmap.Group(uint16(g)))
+		r.VerboseValue += tlsmap.Group(uint16(g)) + ": accepted\n"
+	}
+
+	flag := 0.0
+	if postQuantum {
+		flag = 1
+	}
+	if err := h.PerfData.Add("pq_negotiated", flag, ""); err != nil {
+		r.Error = err
+		return r
+	}
+	if err := h.PerfData.Add("pq_groups", float64(len(accepted)), ""); err != nil {
+		r.Error = err
+		return r
+	}
+
+	summary := "negotiated " + name
+	if len(accepted) > 0 {
+		summary += ", accepts " + strings.Join(accepted, ", ")
+	} else {
+		summary += ", no hybrid ML-KEM groups accepted"
+	}
+
+	if !postQuantum {
+		code := 0
+		if warnClassical {
+			code = 1
+		}
+		r.Findings = append(r.Findings, Finding{Rule: "classical", ReturnCode: code, Message: "classical key exchange " + name + " negotiated"})
+	}
+
+	r.ReturnCode = 0
+	for _, f := range r.Findings {
+		r.ReturnCode = WorstReturnCode(r.ReturnCode, f.ReturnCode)
+	}
+
+	if r.ReturnCode == 1 {
+		r.Value = "Post-quantum warning, " + summary
+	} else {
+		r.Value = "Post-quantum okay, " + summary
+	}
+
+	return r
+}
+
+// tryGroup performs a TLS 1.3 handshake with the host offering only group,
+// and checks that the server agreed to it.
+func (h *HTTPCheck) tryGroup(group tls.CurveID) error {
+	addr, serverName := h.target()
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: h.timeout},
+		Config: &tls.Config{
+			InsecureSkipVerify:   true,
+			ServerName:           serverName,
+			MinVersion:           tls.VersionTLS13,
+			CurvePreferences:     []tls.CurveID{group},
+			GetClientCertificate: h.getClientCertificate,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		if isRemoteAlert(err) {
+			return errors.New("server sent " + remoteAlert(err) + " alert")
+		}
+		return err
+	}
+	defer conn.Close()
+
+	if got := conn.(*tls.Conn).ConnectionState().CurveID; got != group {
+		return errors.New("server chose " + tlsmap.Group(uint16(got)))
+	}
+	return nil
+}
+
+// isHandshakeRefused reports whether a tryGroup error is the server refusing
+// the handshake, rather than a failure to reach it.
+func isHandshakeRefused(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return false
+	}
+	return !errors.Is(err, context.DeadlineExceeded)
+}
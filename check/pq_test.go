@@ -0,0 +1,71 @@
+package check
+
+import (
+	"crypto/tls"
+	"strings"
+	"testing"
+)
+
+func TestCheckPostQuantum(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), leafTemplate(90))
+
+	tests := []struct {
+		name     string
+		groups   []tls.CurveID
+		warn     bool
+		code     int
+		value    string
+		flag     float64
+		accepted []string
+		rejected []string
+	}{
+		{"X25519MLKEM768", []tls.CurveID{tls.X25519MLKEM768, tls.X25519}, true, 0,
+			"Post-quantum okay, negotiated X25519MLKEM768, accepts X25519MLKEM768", 1,
+			[]string{"X25519MLKEM768"}, []string{"SecP256r1MLKEM768", "SecP384r1MLKEM1024"}},
+		{"classical", []tls.CurveID{tls.X25519}, false, 0,
+			"Post-quantum okay, negotiated x25519, no hybrid ML-KEM groups accepted", 0,
+			nil, []string{"X25519MLKEM768", "SecP256r1MLKEM768", "SecP384r1MLKEM1024"}},
+		{"classical warning", []tls.CurveID{tls.X25519}, true, 1,
+			"Post-quantum warning, negotiated x25519, no hybrid ML-KEM groups accepted", 0,
+			nil, []string{"X25519MLKEM768", "SecP256r1MLKEM768", "SecP384r1MLKEM1024"}},
+		{"NIST hybrids", []tls.CurveID{tls.SecP256r1MLKEM768, tls.SecP384r1MLKEM1024, tls.CurveP256}, false, 0,
+			"", -1, []string{"SecP256r1MLKEM768", "SecP384r1MLKEM1024"}, []string{"X25519MLKEM768"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}, CurvePreferences: tt.groups}, false, okHandler)
+			h := fetchTest(t, srv, ca)
+
+			r := h.CheckPostQuantum(tt.warn)
+			if r.Error != nil {
+				t.Fatalf("CheckPostQuantum: %v", r.Error)
+			}
+			// The group negotiated for the fetch depends on the key shares
+			// crypto/tls sends by default, so it's only checked when the
+			// server leaves one choice.
+			if r.ReturnCode != tt.code || (tt.value != "" && r.Value != tt.value) {
+				t.Errorf("CheckPostQuantum = %d %q, want %d %q", r.ReturnCode, r.Value, tt.code, tt.value)
+			}
+
+			got := metrics(h)
+			if tt.flag >= 0 && got["pq_negotiated"] != tt.flag {
+				t.Errorf("perfdata pq_negotiated = %v, want %v", got["pq_negotiated"], tt.flag)
+			}
+			if got["pq_groups"] != float64(len(tt.accepted)) {
+				t.Errorf("perfdata pq_groups = %v, want %d", got["pq_groups"], len(tt.accepted))
+			}
+			for _, g := range tt.accepted {
+				if !strings.Contains(r.VerboseValue, g+": accepted\n") {
+					t.Errorf("CheckPostQuantum details = %q, want %s accepted", r.VerboseValue, g)
+				}
+			}
+			for _, g := range tt.rejected {
+				if !strings.Contains(r.VerboseValue, g+": rejected (") {
+					t.Errorf("CheckPostQuantum details = %q, want %s rejected", r.VerboseValue, g)
+				}
+			}
+		})
+	}
+}
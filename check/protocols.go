@@ -0,0 +1,172 @@
+package check
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/jeffalyanak/check_https_go/tlsmap"
+)
+
+// ParseTLSVersion takes a protocol version like "1.2", "TLS 1.2" or "SSL 3.0"
+// and returns its code.
+func ParseTLSVersion(version string) (uint16, error) {
+	v := strings.ToUpper(strings.TrimSpace(version))
+	if !strings.HasPrefix(v, "SSL") && !strings.HasPrefix(v, "TLS") {
+		v = "TLS " + v
+	}
+	v = strings.Replace(strings.Replace(v, "SSL", "SSL ", 1), "TLS", "TLS ", 1)
+	v = strings.Join(strings.Fields(v), " ")
+
+	for _, code := range tlsmap.TLSVersions() {
+		if tlsmap.TLSVersion(code) == v {
+			return code, nil
+		}
+	}
+	return 0, errors.New("unknown TLS version: " + version)
+}
+
+// CheckProtocols function attempts a handshake pinned to each protocol
+// version from SSL 3.0 to TLS 1.3 and returns the result. SSL 3.0 being
+// accepted is critical, as is no version at or above minVersion being
+// accepted. Any other version below minVersion being accepted is a warning.
+func (h *HTTPCheck) CheckProtocols(minVersion uint16) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if err := h.scanProtocols(); err != nil {
+		r.Error = err
+		return r
+	}
+
+	for _, version := range tlsmap.TLSVersions() {
+		flag := 0.0
+		state := "rejected"
+		if h.protocols[version] {
+			flag = 1
+			state = "accepted"
+		} else if err, ok := h.protocolRejections[version]; ok {
+			state += " (" + err.Error() + ")"
+		}
+		if err := h.PerfData.Add(protocolMetric(version), flag, ""); err != nil {
+			r.Error = err
+			return r
+		}
+		r.VerboseValue += tlsmap.TLSVersion(version) + ": " + state + "\n"
+	}
+
+	var accepted, deprecated []string
+	modern := false
+	for _, version := range tlsmap.TLSVersions() {
+		if !h.protocols[version] {
+			continue
+		}
+		accepted = append(accepted, tlsmap.TLSVersion(version))
+		if version >= minVersion {
+			modern = true
+			continue
+		}
+
+		code := 1
+		if version == versionSSL30 {
+			code = 2
+		}
+		r.ReturnCode = WorstReturnCode(r.ReturnCode, code)
+		deprecated = append(deprecated, tlsmap.TLSVersion(version))
+		r.Findings = append(r.Findings, Finding{Rule: protocolMetric(version), ReturnCode: code, Message: tlsmap.TLSVersion(version) + " is enabled"})
+	}
+
+	if !modern {
+		r.ReturnCode = 2
+		r.Findings = append(r.Findings, Finding{Rule: "min_version", ReturnCode: 2, Message: tlsmap.TLSVersion(minVersion) + " or newer is not offered"})
+	}
+
+	summary := "accepts " + strings.Join(accepted, ", ")
+	if len(accepted) == 0 {
+		summary = "accepts no protocol version"
+	}
+
+	switch {
+	case !modern:
+		r.Value = "Protocols critical, " + tlsmap.TLSVersion(minVersion) + " or newer is not offered, " + summary
+	case r.ReturnCode == 2:
+		r.Value = "Protocols critical, " + strings.Join(deprecated, ", ") + " enabled, " + summary
+	case r.ReturnCode == 1:
+		r.Value = "Protocols warning, " + strings.Join(deprecated, ", ") + " older than " + tlsmap.TLSVersion(minVersion) + " enabled, " + summary
+	default:
+		r.Value = "Protocols okay, " + summary
+	}
+
+	return r
+}
+
+// scanProtocols probes each protocol version once and keeps which were
+// accepted, and the alert sent for those that were refused, so that later
+// checks can reuse the scan.
+func (h *HTTPCheck) scanProtocols() error {
+	if h.protocols != nil {
+		return nil
+	}
+
+	addr, serverName := h.target()
+	protocols := map[uint16]bool{}
+	rejections := map[uint16]error{}
+
+	for _, version := range tlsmap.TLSVersions() {
+		_, err := probeHello(addr, serverName, h.timeout, version, probeSuites(version))
+		if err != nil && !isHelloRejected(err) {
+			return errors.New("protocol scan: " + tlsmap.TLSVersion(version) + ": " + err.Error())
+		}
+		protocols[version] = err == nil
+		if err != nil && err != errHelloRejected {
+			rejections[version] = err
+		}
+	}
+
+	h.protocols = protocols
+	h.protocolRejections = rejections
+	return nil
+}
+
+// target returns the address and server name of the host that served the
+// final response, for the scans that open their own connections.
+func (h *HTTPCheck) target() (string, string) {
+	u := h.resp.Request.URL
+	if u.Port() == "" {
+		return net.JoinHostPort(u.Hostname(), "443"), u.Hostname()
+	}
+	return u.Host, u.Hostname()
+}
+
+// probeSuites returns the cipher suites to offer when probing a version:
+// the TLS 1.3 suites for TLS 1.3, and every other named suite before it.
+func probeSuites(version uint16) []uint16 {
+	var suites []uint16
+	for _, c := range tlsmap.CipherSuites() {
+		isTLS13 := c>>8 == 0x13
+		if version >= versionTLS13 && !isTLS13 {
+			continue
+		}
+		if version < versionTLS13 && (isTLS13 || isSignallingSuite(c)) {
+			continue
+		}
+		suites = append(suites, c)
+	}
+	return suites
+}
+
+// isSignallingSuite reports whether c is a placeholder rather than a real
+// cipher suite: TLS_NULL_WITH_NULL_NULL or one of the SCSVs.
+func isSignallingSuite(c uint16) bool {
+	return c == 0x0000 || c == 0x00FF || c == 0x5600
+}
+
+// protocolMetric returns the perfdata label for a protocol version, like tls1_2.
+func protocolMetric(version uint16) string {
+	name := strings.ToLower(tlsmap.TLSVersion(version))
+	return strings.NewReplacer(" ", "", ".", "_").Replace(name)
+}
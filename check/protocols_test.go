@@ -0,0 +1,104 @@
+package check
+
+import (
+	"crypto/tls"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckProtocols(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), leafTemplate(80))
+
+	tests := []struct {
+		name       string
+		min, max   uint16
+		minVersion uint16
+		code       int
+		value      string
+		accepted   []string
+	}{
+		{"TLS 1.2 and 1.3", tls.VersionTLS12, tls.VersionTLS13, tls.VersionTLS12, 0,
+			"Protocols okay, accepts TLS 1.2, TLS 1.3", []string{"tls1_2", "tls1_3"}},
+		{"TLS 1.3 only", tls.VersionTLS13, tls.VersionTLS13, tls.VersionTLS12, 0,
+			"Protocols okay, accepts TLS 1.3", []string{"tls1_3"}},
+		{"TLS 1.2 only below minimum", tls.VersionTLS12, tls.VersionTLS12, tls.VersionTLS13, 2,
+			"Protocols critical, TLS 1.3 or newer is not offered, accepts TLS 1.2", []string{"tls1_2"}},
+		{"TLS 1.0 enabled", tls.VersionTLS10, tls.VersionTLS12, tls.VersionTLS12, 1,
+			"Protocols warning, TLS 1.0, TLS 1.1 older than TLS 1.2 enabled, accepts TLS 1.0, TLS 1.1, TLS 1.2", []string{"tls1_0", "tls1_1", "tls1_2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tt.min, MaxVersion: tt.max}, false, okHandler)
+			h := fetchTest(t, srv, ca)
+
+			r := h.CheckProtocols(tt.minVersion)
+			if r.Error != nil {
+				t.Fatalf("CheckProtocols: %v", r.Error)
+			}
+			if r.ReturnCode != tt.code || r.Value != tt.value {
+				t.Errorf("CheckProtocols = %d %q, want %d %q", r.ReturnCode, r.Value, tt.code, tt.value)
+			}
+
+			got := metrics(h)
+			for _, label := range []string{"ssl3_0", "tls1_0", "tls1_1", "tls1_2", "tls1_3"} {
+				want := 0.0
+				for _, a := range tt.accepted {
+					if a == label {
+						want = 1
+					}
+				}
+				if v, ok := got[label]; !ok || v != want {
+					t.Errorf("perfdata %s = %v, want %v", label, v, want)
+				}
+			}
+			if !strings.Contains(r.VerboseValue, "SSL 3.0: rejected") {
+				t.Errorf("CheckProtocols details = %q, want SSL 3.0 rejected", r.VerboseValue)
+			}
+		})
+	}
+}
+
+func TestProbeHello(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), leafTemplate(81))
+	srv := newTLSServer(t, &tls.Config{
+		Certificates:     []tls.Certificate{cert},
+		MinVersion:       tls.VersionTLS12,
+		CipherSuites:     []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384},
+		CurvePreferences: []tls.CurveID{tls.X25519},
+	}, false, okHandler)
+	addr := srv.Listener.Addr().String()
+
+	tests := []struct {
+		name    string
+		version uint16
+		suites  []uint16
+		want    serverHello
+		err     string
+	}{
+		{"TLS 1.3", versionTLS13, probeSuites(versionTLS13), serverHello{version: versionTLS13, group: groupX25519}, ""},
+		{"TLS 1.2 suite", versionTLS12, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}, serverHello{version: versionTLS12, cipherSuite: tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}, ""},
+		{"TLS 1.2 suite not enabled", versionTLS12, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256}, serverHello{}, "server sent handshake_failure alert"},
+		{"TLS 1.0", versionTLS10, probeSuites(versionTLS10), serverHello{}, "server sent protocol_version alert"},
+		{"SSL 3.0", versionSSL30, probeSuites(versionSSL30), serverHello{}, "server sent protocol_version alert"},
+	}
+
+	for _, tt := range tests {
+		got, err := probeHello(addr, "localhost", 5*time.Second, tt.version, tt.suites)
+		switch {
+		case tt.err != "":
+			if err == nil || err.Error() != tt.err || !isHelloRejected(err) {
+				t.Errorf("%s: probeHello = %+v, %v, want %q", tt.name, got, err, tt.err)
+			}
+		case err != nil:
+			t.Errorf("%s: probeHello: %v", tt.name, err)
+		default:
+			if got.version != tt.want.version || (tt.want.cipherSuite != 0 && got.cipherSuite != tt.want.cipherSuite) || got.group != tt.want.group {
+				t.Errorf("%s: probeHello = %+v, want %+v", tt.name, *got, tt.want)
+			}
+		}
+	}
+}
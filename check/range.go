@@ -0,0 +1,208 @@
+package check
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Range is a Nagios plugin threshold range, written [@][start:][end]. A
+// missing start is 0, a start of ~ is negative infinity and a missing end is
+// positive infinity. Values outside the range alert, or values inside it
+// when Inside is set by a leading @.
+type Range struct {
+	Start  float64
+	End    float64
+	Inside bool
+}
+
+// Thresholds holds the warning and critical ranges of one metric. Either is
+// nil when that level isn't set.
+type Thresholds struct {
+	Warn *Range
+	Crit *Range
+}
+
+// ParseRange takes a range like "10", "10:", "~:20", "5:10" or "@5:10" and
+// returns the Range it describes.
+func ParseRange(s string) (Range, error) {
+	return parseRange(s, parsePerfNumber)
+}
+
+// ParseDaysRange takes a range of days left like ParseRange, except that a
+// bare number N means N:, alerting when fewer than N days are left, as -w and
+// -c always have.
+func ParseDaysRange(s string) (Range, error) {
+	if v, err := parsePerfNumber(strings.TrimSpace(s)); err == nil {
+		return Range{Start: v, End: math.Inf(1)}, nil
+	}
+	return ParseRange(s)
+}
+
+// ParseDurationRange takes a range like ParseRange whose bounds are Go
+// durations like 500ms or 2s, or plain numbers of milliseconds, and returns it
+// in milliseconds.
+func ParseDurationRange(s string) (Range, error) {
+	return parseRange(s, func(bound string) (float64, error) {
+		if v, err := parsePerfNumber(bound); err == nil {
+			return v, nil
+		}
+		d, err := time.ParseDuration(bound)
+		if err != nil {
+			return 0, errors.New(strconv.Quote(bound) + " is not a duration")
+		}
+		return millis(d), nil
+	})
+}
+
+// ParseThresholds parses a warning and a critical range with parse, leaving
+// out either if it is empty.
+func ParseThresholds(warn string, crit string, parse func(string) (Range, error)) (Thresholds, error) {
+	var t Thresholds
+	for _, level := range []struct {
+		spec   string
+		target **Range
+	}{{warn, &t.Warn}, {crit, &t.Crit}} {
+		if level.spec == "" {
+			continue
+		}
+		r, err := parse(level.spec)
+		if err != nil {
+			return t, err
+		}
+		*level.target = &r
+	}
+	return t, nil
+}
+
+// parseRange reads a range whose bounds are read by parseBound.
+func parseRange(s string, parseBound func(string) (float64, error)) (Range, error) {
+	r := Range{Start: 0, End: math.Inf(1)}
+	spec := strings.TrimSpace(s)
+
+	if strings.HasPrefix(spec, "@") {
+		r.Inside = true
+		spec = spec[1:]
+	}
+	if spec == "" {
+		return r, errors.New("range " + strconv.Quote(s) + " is empty")
+	}
+
+	start, end, hasStart := strings.Cut(spec, ":")
+	if !hasStart {
+		start, end = "", spec
+	}
+
+	if hasStart {
+		switch start {
+		case "~":
+			r.Start = math.Inf(-1)
+		case "":
+			return r, errors.New("range " + strconv.Quote(s) + " has an empty start, use ~ for negative infinity")
+		default:
+			v, err := parseBound(start)
+			if err != nil {
+				return r, errors.New("range " + strconv.Quote(s) + ": " + err.Error())
+			}
+			r.Start = v
+		}
+	}
+
+	if end != "" {
+		v, err := parseBound(end)
+		if err != nil {
+			return r, errors.New("range " + strconv.Quote(s) + ": " + err.Error())
+		}
+		r.End = v
+	}
+
+	if r.Start > r.End {
+		return r, errors.New("range " + strconv.Quote(s) + " starts after it ends")
+	}
+	return r, nil
+}
+
+// Alert reports whether v is outside the range, or inside it when Inside is
+// set.
+func (r Range) Alert(v float64) bool {
+	inside := v >= r.Start && v <= r.End
+	return inside == r.Inside
+}
+
+// Describe explains why v alerts against the range, with bounds given in
+// unit, like "over 10ms" or "within 5 to 10 days".
+func (r Range) Describe(v float64, unit string) string {
+	start, end := formatPerfNumber(r.Start)+unit, formatPerfNumber(r.End)+unit
+	switch {
+	case r.Inside && math.IsInf(r.Start, -1):
+		return "at most " + end
+	case r.Inside && math.IsInf(r.End, 1):
+		return "at least " + start
+	case r.Inside:
+		return "within " + start + " to " + end
+	case v < r.Start:
+		return "under " + start
+	}
+	return "over " + end
+}
+
+// Evaluate returns 2 if v alerts against the critical range, 1 if it alerts
+// against the warning range, and 0 otherwise.
+func (t Thresholds) Evaluate(v float64) int {
+	switch {
+	case t.Crit != nil && t.Crit.Alert(v):
+		return 2
+	case t.Warn != nil && t.Warn.Alert(v):
+		return 1
+	}
+	return 0
+}
+
+// Breached returns the range v alerts against, the critical one first, or
+// nil if it alerts against neither.
+func (t Thresholds) Breached(v float64) *Range {
+	switch t.Evaluate(v) {
+	case 2:
+		return t.Crit
+	case 1:
+		return t.Warn
+	}
+	return nil
+}
+
+// String formats the range in its shortest Nagios form.
+func (r Range) String() string {
+	var b strings.Builder
+	if r.Inside {
+		b.WriteString("@")
+	}
+
+	switch {
+	case math.IsInf(r.Start, -1):
+		b.WriteString("~:")
+	case r.Start != 0 || math.IsInf(r.End, 1):
+		b.WriteString(formatPerfNumber(r.Start) + ":")
+	}
+
+	if !math.IsInf(r.End, 1) {
+		b.WriteString(formatPerfNumber(r.End))
+	}
+	return b.String()
+}
+
+// parsePerfNumber reads a number as written in perfdata and ranges.
+func parsePerfNumber(s string) (float64, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, errors.New(strconv.Quote(s) + " is not a number")
+	}
+	return v, nil
+}
+
+// formatPerfNumber writes a number in the plain decimal form perfdata
+// requires, without an exponent.
+func formatPerfNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
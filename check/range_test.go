@@ -0,0 +1,125 @@
+package check
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		spec  string
+		parse func(string) (Range, error)
+		want  Range
+		form  string
+	}{
+		{"10", ParseRange, Range{End: 10}, "10"},
+		{"10:", ParseRange, Range{Start: 10, End: inf}, "10:"},
+		{"~:20", ParseRange, Range{Start: math.Inf(-1), End: 20}, "~:20"},
+		{"5:10", ParseRange, Range{Start: 5, End: 10}, "5:10"},
+		{"@5:10", ParseRange, Range{Start: 5, End: 10, Inside: true}, "@5:10"},
+		{"@~:0", ParseRange, Range{Start: math.Inf(-1), End: 0, Inside: true}, "@~:0"},
+		{"0:", ParseRange, Range{End: inf}, "0:"},
+		{" -1.5:2.25 ", ParseRange, Range{Start: -1.5, End: 2.25}, "-1.5:2.25"},
+		{"30", ParseDaysRange, Range{Start: 30, End: inf}, "30:"},
+		{"0:30", ParseDaysRange, Range{End: 30}, "30"},
+		{"@10:20", ParseDaysRange, Range{Start: 10, End: 20, Inside: true}, "@10:20"},
+		{"500ms", ParseDurationRange, Range{End: 500}, "500"},
+		{"1s:2.5s", ParseDurationRange, Range{Start: 1000, End: 2500}, "1000:2500"},
+		{"250", ParseDurationRange, Range{End: 250}, "250"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.parse(tt.spec)
+		if err != nil {
+			t.Errorf("parse %q: %v", tt.spec, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parse %q = %+v, want %+v", tt.spec, got, tt.want)
+		}
+		if s := got.String(); s != tt.form {
+			t.Errorf("parse %q String() = %q, want %q", tt.spec, s, tt.form)
+		}
+		if again, err := ParseRange(got.String()); err != nil || again != got {
+			t.Errorf("ParseRange(%q) = %+v, %v, want %+v back", got.String(), again, err, got)
+		}
+	}
+}
+
+func TestParseRangeInvalid(t *testing.T) {
+	for _, tt := range []struct {
+		spec  string
+		parse func(string) (Range, error)
+	}{
+		{"", ParseRange},
+		{"@", ParseRange},
+		{":10", ParseRange},
+		{"~", ParseRange},
+		{"10:5", ParseRange},
+		{"a:b", ParseRange},
+		{"NaN", ParseRange},
+		{"Inf", ParseRange},
+		{":10", ParseDaysRange},
+		{"10:5", ParseDaysRange},
+		{"soon", ParseDurationRange},
+		{"2s:1s", ParseDurationRange},
+	} {
+		if r, err := tt.parse(tt.spec); err == nil {
+			t.Errorf("parse %q = %+v, want an error", tt.spec, r)
+		}
+	}
+}
+
+func TestThresholdsEvaluate(t *testing.T) {
+	th, err := ParseThresholds("10", "20", ParseRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	days, err := ParseThresholds("30", "7", ParseDaysRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inside, err := ParseThresholds("", "@5:10", ParseRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		th   Thresholds
+		v    float64
+		want int
+	}{
+		{"under warn", th, 5, 0},
+		{"at warn end", th, 10, 0},
+		{"over warn", th, 15, 1},
+		{"over crit", th, 25, 2},
+		{"below zero", th, -1, 2},
+		{"days plenty", days, 90, 0},
+		{"days warn", days, 20, 1},
+		{"days crit", days, 3, 2},
+		{"inside", inside, 7, 2},
+		{"outside inside", inside, 11, 0},
+		{"none", Thresholds{}, 1e9, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.th.Evaluate(tt.v); got != tt.want {
+			t.Errorf("%s: Evaluate(%v) = %d, want %d", tt.name, tt.v, got, tt.want)
+		}
+		breached := tt.th.Breached(tt.v)
+		if (breached != nil) != (tt.want != 0) {
+			t.Errorf("%s: Breached(%v) = %+v, want a range only when Evaluate alerts", tt.name, tt.v, breached)
+		}
+	}
+
+	if inside.Warn != nil {
+		t.Errorf("ParseThresholds with an empty warning = %+v, want no warning range", inside.Warn)
+	}
+	if d := days.Crit.Describe(3, " days"); d != "under 7 days" {
+		t.Errorf("Describe = %q, want %q", d, "under 7 days")
+	}
+	if d := inside.Crit.Describe(7, "ms"); d != "within 5ms to 10ms" {
+		t.Errorf("Describe = %q, want %q", d, "within 5ms to 10ms")
+	}
+}
@@ -0,0 +1,196 @@
+package check
+
+import (
+	"math"
+	"time"
+
+	"github.com/jeffalyanak/check_https_go/tlsmap"
+)
+
+// ReportSchemaVersion is the version of the Report JSON schema. It is raised
+// whenever a field is removed, renamed or changes meaning, but not when one is
+// added.
+const ReportSchemaVersion = 1
+
+// Report is the whole outcome of a run of the checks, as written by the JSON
+// output mode. The schema is described in the README.
+type Report struct {
+	SchemaVersion int                 `json:"schema_version"`
+	URL           string              `json:"url"`
+	FinalURL      string              `json:"final_url,omitempty"`
+	StatusCode    int                 `json:"status_code,omitempty"`
+	State         string              `json:"state"`
+	ExitCode      int                 `json:"exit_code"`
+	Checks        []CheckReport       `json:"checks"`
+	Metrics       []MetricReport      `json:"metrics"`
+	Redirects     []RedirectReport    `json:"redirects"`
+	Certificates  []CertificateReport `json:"certificates"`
+	TLS           *TLSReport          `json:"tls,omitempty"`
+	TookMillis    int64               `json:"took_ms"`
+}
+
+// CheckReport is the outcome of one sub-check
+type CheckReport struct {
+	Name     string          `json:"name"`
+	State    string          `json:"state"`
+	ExitCode int             `json:"exit_code"`
+	Message  string          `json:"message,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Details  string          `json:"details,omitempty"`
+	Findings []FindingReport `json:"findings,omitempty"`
+}
+
+// FindingReport is the outcome of one rule within a sub-check
+type FindingReport struct {
+	Rule     string `json:"rule"`
+	State    string `json:"state"`
+	ExitCode int    `json:"exit_code"`
+	Message  string `json:"message"`
+}
+
+// MetricReport is one perfdata metric. Value is nil when it is unknown, and
+// the thresholds are Nagios ranges.
+type MetricReport struct {
+	Label string   `json:"label"`
+	Value *float64 `json:"value"`
+	UOM   string   `json:"uom,omitempty"`
+	Warn  string   `json:"warn,omitempty"`
+	Crit  string   `json:"crit,omitempty"`
+	Min   *float64 `json:"min,omitempty"`
+	Max   *float64 `json:"max,omitempty"`
+}
+
+// RedirectReport is one redirect followed by Fetch
+type RedirectReport struct {
+	URL    string `json:"url"`
+	Status int    `json:"status"`
+	To     string `json:"to"`
+}
+
+// CertificateReport describes one certificate of the served and verified
+// chains, in the order of CheckCertificate.
+type CertificateReport struct {
+	Position  int       `json:"position"`
+	Subject   string    `json:"subject"`
+	Issuer    string    `json:"issuer"`
+	Serial    string    `json:"serial"`
+	NotBefore time.Time `json:"not_before"`
+	NotAfter  time.Time `json:"not_after"`
+	DaysLeft  int       `json:"days_left"`
+	SHA256    string    `json:"sha256"`
+	Served    bool      `json:"served"`
+}
+
+// TLSReport holds the parameters of the connection the final response was
+// received on.
+type TLSReport struct {
+	Version     string `json:"version"`
+	CipherSuite string `json:"cipher_suite"`
+	KeyExchange string `json:"key_exchange,omitempty"`
+	ALPN        string `json:"alpn,omitempty"`
+}
+
+// StateName returns the Nagios state name of a return code.
+func StateName(code int) string {
+	switch code {
+	case 0:
+		return "OK"
+	case 1:
+		return "WARNING"
+	case 2:
+		return "CRITICAL"
+	}
+	return "UNKNOWN"
+}
+
+// Report returns the redirects, certificates, TLS parameters and perfdata of
+// the last Fetch, with no checks added yet.
+func (h *HTTPCheck) Report() Report {
+	rep := Report{
+		SchemaVersion: ReportSchemaVersion,
+		URL:           "https://" + h.URL,
+		State:         StateName(0),
+		Checks:        []CheckReport{},
+		Metrics:       []MetricReport{},
+		Redirects:     []RedirectReport{},
+		Certificates:  []CertificateReport{},
+		TookMillis:    h.PerfData.Took().Milliseconds(),
+	}
+
+	for _, m := range h.PerfData.Metrics() {
+		metric := MetricReport{Label: m.Label, UOM: m.UOM, Min: m.Min, Max: m.Max}
+		if !m.Unknown {
+			v := m.Value
+			metric.Value = &v
+		}
+		if m.Warn != nil {
+			metric.Warn = m.Warn.String()
+		}
+		if m.Crit != nil {
+			metric.Crit = m.Crit.String()
+		}
+		rep.Metrics = append(rep.Metrics, metric)
+	}
+
+	if h.resp == nil {
+		return rep
+	}
+	rep.FinalURL = h.resp.Request.URL.String()
+	rep.StatusCode = h.resp.StatusCode
+
+	for i := 0; i+1 < len(h.traces); i++ {
+		rep.Redirects = append(rep.Redirects, RedirectReport{URL: h.traces[i].url, Status: h.traces[i].status, To: h.traces[i+1].url})
+	}
+
+	now := time.Now()
+	served := 0
+	if h.resp.TLS != nil {
+		served = len(h.resp.TLS.PeerCertificates)
+	}
+	for i, c := range h.certificates() {
+		rep.Certificates = append(rep.Certificates, CertificateReport{
+			Position:  i,
+			Subject:   c.Subject.String(),
+			Issuer:    c.Issuer.String(),
+			Serial:    c.SerialNumber.Text(16),
+			NotBefore: c.NotBefore.UTC(),
+			NotAfter:  c.NotAfter.UTC(),
+			DaysLeft:  int(math.Floor(c.NotAfter.Sub(now).Hours() / 24)),
+			SHA256:    CertFingerprint(c),
+			Served:    i < served,
+		})
+	}
+
+	if state := h.resp.TLS; state != nil {
+		rep.TLS = &TLSReport{
+			Version:     tlsmap.TLSVersion(state.Version),
+			CipherSuite: tlsmap.CipherSuite(state.CipherSuite),
+			ALPN:        state.NegotiatedProtocol,
+		}
+		if state.CurveID != 0 {
+			rep.TLS.KeyExchange = tlsmap.Group(uint16(state.CurveID))
+		}
+	}
+
+	return rep
+}
+
+// AddCheck adds the result of the sub-check name to the report, raising the
+// report's state to the result's if it is worse. A result with an error is
+// unknown.
+func (rep *Report) AddCheck(name string, r Result) {
+	c := CheckReport{Name: name, ExitCode: r.ReturnCode, Message: r.Value, Details: r.VerboseValue}
+	if r.Error != nil {
+		c.ExitCode = 3
+		c.Error = r.Error.Error()
+	}
+	c.State = StateName(c.ExitCode)
+
+	for _, f := range r.Findings {
+		c.Findings = append(c.Findings, FindingReport{Rule: f.Rule, State: StateName(f.ReturnCode), ExitCode: f.ReturnCode, Message: f.Message})
+	}
+
+	rep.Checks = append(rep.Checks, c)
+	rep.ExitCode = WorstReturnCode(rep.ExitCode, c.ExitCode)
+	rep.State = StateName(rep.ExitCode)
+}
@@ -0,0 +1,121 @@
+package check
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestReportGolden(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello from the test server\n"))
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/gone", http.StatusFound)
+	})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "gone", http.StatusNotFound)
+	})
+	// The version, suite and group are pinned so the golden files don't
+	// depend on AES hardware support or Go's default preferences.
+	srv := httptest.NewUnstartedServer(mux)
+	srv.TLS = &tls.Config{
+		MaxVersion:       tls.VersionTLS12,
+		CipherSuites:     []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+		CurvePreferences: []tls.CurveID{tls.X25519},
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(srv.Certificate())
+	host := strings.TrimPrefix(srv.URL, "https://")
+
+	tests := []struct {
+		golden string
+		path   string
+		roots  *x509.CertPool
+	}{
+		{"report_ok.golden", "/", roots},
+		{"report_redirect_untrusted.golden", "/moved", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			h := &HTTPCheck{URL: host + tt.path, RootCAs: tt.roots}
+			if r := h.Fetch(2, "check_https_go test", 5); r.Error != nil {
+				t.Fatalf("Fetch: %v", r.Error)
+			}
+
+			days, err := ParseThresholds("30", "5", ParseDaysRange)
+			if err != nil {
+				t.Fatal(err)
+			}
+			size, err := ParseThresholds("", "", ParseRange)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checks := []struct {
+				name   string
+				result Result
+			}{
+				{"TLS Chain", h.CheckChain()},
+				{"Status Code", h.CheckStatus("200")},
+				{"Web Content", h.CheckContent("hello")},
+				{"TLS Certificate", h.CheckCertificate(days)},
+				{"Response", h.CheckResponse(size, size)},
+			}
+
+			rep := h.Report()
+			for _, c := range checks {
+				rep.AddCheck(c.name, c.result)
+			}
+
+			// Zero what depends on when the test runs.
+			rep.TookMillis = 0
+			for i := range rep.Certificates {
+				rep.Certificates[i].DaysLeft = 0
+			}
+			for i, m := range rep.Metrics {
+				if strings.HasPrefix(m.Label, "days_left_") {
+					zero := 0.0
+					rep.Metrics[i].Value = &zero
+				}
+			}
+
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(rep); err != nil {
+				t.Fatal(err)
+			}
+			got := bytes.ReplaceAll(buf.Bytes(), []byte(host), []byte("127.0.0.1:PORT"))
+
+			path := filepath.Join("testdata", tt.golden)
+			if *update {
+				if err := os.WriteFile(path, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("report differs from %s, run go test -update to rewrite it after checking the change\ngot:\n%s", path, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package check
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CheckResponse function reports the size of the fetched body and the number
+// of redirects followed to reach it as perfdata, compares each against its
+// warn and crit ranges, and returns the result.
+func (h *HTTPCheck) CheckResponse(size Thresholds, redirects Thresholds) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	zero, limit := 0.0, float64(h.redirects)
+	metrics := []struct {
+		rule   string
+		unit   string
+		levels Thresholds
+		metric Metric
+	}{
+		{"size", " bytes", size, Metric{Label: "size", Value: float64(len(h.body)), UOM: "B", Min: &zero}},
+		{"redirects", " redirects", redirects, Metric{Label: "redirects", Value: float64(len(h.traces) - 1), Min: &zero, Max: &limit}},
+	}
+
+	for _, m := range metrics {
+		m.metric.Warn, m.metric.Crit = m.levels.Warn, m.levels.Crit
+		if err := h.PerfData.AddMetric(m.metric); err != nil {
+			r.Error = err
+			return r
+		}
+
+		v := m.metric.Value
+		if breached := m.levels.Breached(v); breached != nil {
+			r.Findings = append(r.Findings, Finding{Rule: m.rule, ReturnCode: m.levels.Evaluate(v), Message: formatPerfNumber(v) + m.unit + ", " + breached.Describe(v, m.unit)})
+		}
+	}
+
+	summary := strconv.Itoa(len(h.body)) + " bytes after " + strconv.Itoa(len(h.traces)-1) + " redirects"
+	r.VerboseValue = "Response size: " + strconv.Itoa(len(h.body)) + " bytes\n"
+
+	var messages []string
+	for _, f := range r.Findings {
+		r.ReturnCode = WorstReturnCode(r.ReturnCode, f.ReturnCode)
+		messages = append(messages, f.Message)
+	}
+
+	switch r.ReturnCode {
+	case 0:
+		r.Value = "Response okay, " + summary
+	case 1:
+		r.Value = "Response warning, " + strings.Join(messages, ", ")
+	default:
+		r.Value = "Response critical, " + strings.Join(messages, ", ")
+	}
+	return r
+}
@@ -2,10 +2,28 @@ package check
 
 // Result holds information about a completed check
 type Result struct {
-	URL          string // URL for request
-	ReturnCode   int    // Code to return to OS after check
-	Status       int    // HTTP status code
-	Value        string // Result text value
-	VerboseValue string // Additional, optional information
-	Error        error  // Error during check
+	URL          string    // URL for request
+	ReturnCode   int       // Code to return to OS after check
+	Status       int       // HTTP status code
+	Value        string    // Result text value
+	VerboseValue string    // Additional, optional information
+	Findings     []Finding // Outcome of each rule, for checks made of several rules
+	Error        error     // Error during check
+}
+
+// Finding holds the outcome of a single rule within a check
+type Finding struct {
+	Rule       string // Name of the rule
+	ReturnCode int    // Code for this rule alone
+	Message    string // What the rule found
+}
+
+// WorstReturnCode returns the more severe of two return codes, ranking
+// critical above unknown above warning above OK.
+func WorstReturnCode(a int, b int) int {
+	rank := map[int]int{0: 0, 1: 1, 3: 2, 2: 3}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
 }
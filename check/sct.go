@@ -0,0 +1,399 @@
+package check
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+	cbasn1 "golang.org/x/crypto/cryptobyte/asn1"
+	"golang.org/x/crypto/ocsp"
+)
+
+// SCT list extensions in certificates (RFC 6962 3.3) and OCSP responses (3.3).
+var (
+	oidSCTList     = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}
+	oidOCSPSCTList = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 5}
+)
+
+// LogEntryType values that an SCT signs over.
+const (
+	sctX509Entry    = 0
+	sctPrecertEntry = 1
+)
+
+// CTLog is a Certificate Transparency log trusted to sign SCTs.
+type CTLog struct {
+	Description string
+	Operator    string
+	Key         crypto.PublicKey
+}
+
+// CTLogList holds the trusted logs keyed by log ID.
+type CTLogList struct {
+	Logs map[[sha256.Size]byte]CTLog
+}
+
+// LoadCTLogList reads a log list JSON file in the v3 schema published for
+// Chrome and Apple, as in https://www.gstatic.com/ct/log_list/v3/log_list.json.
+// Logs in the rejected state are left out.
+func LoadCTLogList(path string) (*CTLogList, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var list struct {
+		Operators []struct {
+			Name string `json:"name"`
+			Logs []struct {
+				Description string                     `json:"description"`
+				Key         string                     `json:"key"`
+				State       map[string]json.RawMessage `json:"state"`
+			} `json:"logs"`
+		} `json:"operators"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, errors.New("CT log list: " + err.Error())
+	}
+
+	logs := &CTLogList{Logs: map[[sha256.Size]byte]CTLog{}}
+	for _, operator := range list.Operators {
+		for _, l := range operator.Logs {
+			if _, rejected := l.State["rejected"]; rejected {
+				continue
+			}
+
+			der, err := base64.StdEncoding.DecodeString(l.Key)
+			if err != nil {
+				return nil, errors.New("CT log list: key of " + l.Description + ": " + err.Error())
+			}
+			key, err := x509.ParsePKIXPublicKey(der)
+			if err != nil {
+				return nil, errors.New("CT log list: key of " + l.Description + ": " + err.Error())
+			}
+
+			// The log ID is defined as the hash of its key.
+			logs.Logs[sha256.Sum256(der)] = CTLog{
+				Description: l.Description,
+				Operator:    operator.Name,
+				Key:         key,
+			}
+		}
+	}
+
+	if len(logs.Logs) == 0 {
+		return nil, errors.New("CT log list: no logs found in " + path)
+	}
+	return logs, nil
+}
+
+// sct is a parsed SignedCertificateTimestamp and where it was delivered.
+type sct struct {
+	source     string
+	entryType  uint16
+	logID      [sha256.Size]byte
+	timestamp  uint64
+	extensions []byte
+	hashAlg    uint8
+	sigAlg     uint8
+	signature  []byte
+}
+
+// CheckSCT function verifies the SCTs delivered in the certificate, the TLS
+// extension and the stapled OCSP response against the trusted logs and
+// returns the result. Fewer than minOperators distinct log operators with a
+// valid SCT is critical.
+func (h *HTTPCheck) CheckSCT(logs *CTLogList, minOperators int) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if h.resp.TLS == nil || len(h.resp.TLS.PeerCertificates) == 0 {
+		r.Error = errors.New("TLS error: no certificates returned")
+		return r
+	}
+
+	leaf := h.resp.TLS.PeerCertificates[0]
+	issuer := h.issuer()
+
+	scts, skipped := h.collectSCTs()
+	for _, reason := range skipped {
+		r.VerboseValue += reason + "\n"
+	}
+
+	valid := 0
+	operators := map[string]bool{}
+	now := time.Now()
+	for _, s := range scts {
+		log, known := logs.Logs[s.logID]
+		name := "unknown log " + base64.StdEncoding.EncodeToString(s.logID[:])
+		if known {
+			name = log.Description
+		}
+		ts := time.UnixMilli(int64(s.timestamp))
+		r.VerboseValue += "SCT " + s.source + " from " + name + " at " + ts.Format("January 02, 2006 15:04") + ": "
+
+		switch {
+		case !known:
+			r.VerboseValue += "not trusted\n"
+		case ts.After(now):
+			r.VerboseValue += "timestamp in the future\n"
+		default:
+			if err := s.verify(log.Key, leaf, issuer); err != nil {
+				r.VerboseValue += "invalid, " + err.Error() + "\n"
+				continue
+			}
+			r.VerboseValue += "valid\n"
+			valid++
+			operators[log.Operator] = true
+		}
+	}
+
+	if err := h.PerfData.Add("sct_valid", float64(valid), ""); err != nil {
+		r.Error = err
+		return r
+	}
+	if err := h.PerfData.Add("sct_operators", float64(len(operators)), ""); err != nil {
+		r.Error = err
+		return r
+	}
+
+	summary := strconv.Itoa(valid) + " valid SCTs of " + strconv.Itoa(len(scts)) +
+		" from " + strconv.Itoa(len(operators)) + " log operators"
+	if len(operators) < minOperators {
+		r.ReturnCode = 2
+		r.Value = "SCT critical, " + summary + ", at least " + strconv.Itoa(minOperators) + " needed"
+		return r
+	}
+
+	r.ReturnCode = 0
+	r.Value = "SCT okay, " + summary
+	return r
+}
+
+// collectSCTs gathers the SCTs embedded in the leaf certificate, sent in the
+// TLS extension and included in the stapled OCSP response. SCTs that can't
+// be parsed, or have a version other than v1, are skipped as RFC 6962 3.2
+// requires, and the reasons returned.
+func (h *HTTPCheck) collectSCTs() ([]sct, []string) {
+	var scts []sct
+	var skipped []string
+	leaf := h.resp.TLS.PeerCertificates[0]
+
+	for _, ext := range leaf.Extensions {
+		if ext.Id.Equal(oidSCTList) {
+			list, reasons := parseSCTList(ext.Value, "embedded", sctPrecertEntry)
+			scts = append(scts, list...)
+			skipped = append(skipped, reasons...)
+		}
+	}
+
+	for _, raw := range h.resp.TLS.SignedCertificateTimestamps {
+		s, err := parseSCT(raw, "in TLS extension", sctX509Entry)
+		if err != nil {
+			skipped = append(skipped, err.Error())
+			continue
+		}
+		scts = append(scts, s)
+	}
+
+	// The staple is parsed here unless CheckOCSP already did so.
+	resp := h.ocspResponse
+	if resp == nil && len(h.resp.TLS.OCSPResponse) > 0 {
+		if issuer := h.issuer(); issuer != nil {
+			resp, _ = ocsp.ParseResponseForCert(h.resp.TLS.OCSPResponse, leaf, issuer)
+		}
+	}
+
+	if resp != nil {
+		for _, ext := range resp.Extensions {
+			if ext.Id.Equal(oidOCSPSCTList) {
+				list, reasons := parseSCTList(ext.Value, "in OCSP response", sctX509Entry)
+				scts = append(scts, list...)
+				skipped = append(skipped, reasons...)
+			}
+		}
+	}
+
+	return scts, skipped
+}
+
+// parseSCTList parses the DER OCTET STRING wrapped SignedCertificateTimestampList
+// of an extension, and returns the reasons for the SCTs it skipped.
+func parseSCTList(value []byte, source string, entryType uint16) ([]sct, []string) {
+	var scts []sct
+	var skipped []string
+	var octets, list cryptobyte.String
+
+	input := cryptobyte.String(value)
+	if !input.ReadASN1(&octets, cbasn1.OCTET_STRING) || !octets.ReadUint16LengthPrefixed(&list) {
+		return nil, []string{"SCT list " + source + ": skipped, malformed"}
+	}
+
+	for !list.Empty() {
+		var raw cryptobyte.String
+		if !list.ReadUint16LengthPrefixed(&raw) {
+			return scts, append(skipped, "SCT list "+source+": rest skipped, malformed")
+		}
+		s, err := parseSCT(raw, source, entryType)
+		if err != nil {
+			skipped = append(skipped, err.Error())
+			continue
+		}
+		scts = append(scts, s)
+	}
+	return scts, skipped
+}
+
+// parseSCT parses a single serialized v1 SCT.
+func parseSCT(raw []byte, source string, entryType uint16) (sct, error) {
+	s := sct{source: source, entryType: entryType}
+	var version uint8
+	var logID []byte
+	var extensions, signature cryptobyte.String
+
+	input := cryptobyte.String(raw)
+	if !input.ReadUint8(&version) {
+		return s, errors.New("SCT " + source + ": skipped, malformed")
+	}
+	if version != 0 {
+		return s, errors.New("SCT " + source + ": skipped, unsupported version v" + strconv.Itoa(int(version)+1))
+	}
+	if !input.ReadBytes(&logID, sha256.Size) ||
+		!input.ReadUint64(&s.timestamp) ||
+		!input.ReadUint16LengthPrefixed(&extensions) ||
+		!input.ReadUint8(&s.hashAlg) ||
+		!input.ReadUint8(&s.sigAlg) ||
+		!input.ReadUint16LengthPrefixed(&signature) ||
+		!input.Empty() {
+		return s, errors.New("SCT " + source + ": skipped, malformed")
+	}
+
+	copy(s.logID[:], logID)
+	s.extensions = extensions
+	s.signature = signature
+	return s, nil
+}
+
+// verify checks the SCT signature over the certificate, or over the
+// precertificate the log saw for an embedded SCT.
+func (s sct) verify(key crypto.PublicKey, leaf *x509.Certificate, issuer *x509.Certificate) error {
+	var b cryptobyte.Builder
+	b.AddUint8(0) // version v1
+	b.AddUint8(0) // signature type certificate_timestamp
+	b.AddUint64(s.timestamp)
+	b.AddUint16(s.entryType)
+
+	if s.entryType == sctPrecertEntry {
+		if issuer == nil {
+			return errors.New("issuer of " + leaf.Subject.String() + " not found")
+		}
+		tbs, err := precertTBS(leaf.RawTBSCertificate)
+		if err != nil {
+			return err
+		}
+		issuerKeyHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+		b.AddBytes(issuerKeyHash[:])
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(tbs) })
+	} else {
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(leaf.Raw) })
+	}
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(s.extensions) })
+
+	signed, err := b.Bytes()
+	if err != nil {
+		return err
+	}
+
+	// Logs sign with SHA-256 (4), using RSA (1) or ECDSA (3).
+	if s.hashAlg != 4 {
+		return errors.New("unsupported hash algorithm " + strconv.Itoa(int(s.hashAlg)))
+	}
+	digest := sha256.Sum256(signed)
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if s.sigAlg != 3 || !ecdsa.VerifyASN1(k, digest[:], s.signature) {
+			return errors.New("bad signature")
+		}
+	case *rsa.PublicKey:
+		if s.sigAlg != 1 || rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], s.signature) != nil {
+			return errors.New("bad signature")
+		}
+	default:
+		return errors.New("unsupported log key type")
+	}
+	return nil
+}
+
+// precertTBS rebuilds the TBSCertificate a log signed for an embedded SCT,
+// which is the leaf's TBSCertificate without the SCT list extension.
+func precertTBS(raw []byte) ([]byte, error) {
+	var tbs cryptobyte.String
+	input := cryptobyte.String(raw)
+	if !input.ReadASN1(&tbs, cbasn1.SEQUENCE) {
+		return nil, errors.New("malformed TBSCertificate")
+	}
+
+	extensionsTag := cbasn1.Tag(3).Constructed().ContextSpecific()
+
+	var b cryptobyte.Builder
+	var fail error
+	b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		for !tbs.Empty() {
+			var element cryptobyte.String
+			var tag cbasn1.Tag
+			if !tbs.ReadAnyASN1Element(&element, &tag) {
+				fail = errors.New("malformed TBSCertificate")
+				return
+			}
+			if tag != extensionsTag {
+				b.AddBytes(element)
+				continue
+			}
+
+			var wrapper, extensions cryptobyte.String
+			if !element.ReadASN1(&wrapper, extensionsTag) || !wrapper.ReadASN1(&extensions, cbasn1.SEQUENCE) {
+				fail = errors.New("malformed TBSCertificate extensions")
+				return
+			}
+			b.AddASN1(extensionsTag, func(b *cryptobyte.Builder) {
+				b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+					for !extensions.Empty() {
+						var ext, body cryptobyte.String
+						var id asn1.ObjectIdentifier
+						if !extensions.ReadASN1Element(&ext, cbasn1.SEQUENCE) {
+							fail = errors.New("malformed TBSCertificate extension")
+							return
+						}
+						element := ext
+						if !element.ReadASN1(&body, cbasn1.SEQUENCE) || !body.ReadASN1ObjectIdentifier(&id) {
+							fail = errors.New("malformed TBSCertificate extension")
+							return
+						}
+						if !id.Equal(oidSCTList) {
+							b.AddBytes(ext)
+						}
+					}
+				})
+			})
+		}
+	})
+	if fail != nil {
+		return nil, fail
+	}
+	return b.Bytes()
+}
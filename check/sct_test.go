@@ -0,0 +1,205 @@
+package check
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// testLog is a CT log that signs SCTs in the tests.
+type testLog struct {
+	key crypto.Signer
+	der []byte
+}
+
+// newTestLog returns a log signing with key.
+func newTestLog(t *testing.T, key crypto.Signer) testLog {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testLog{key: key, der: der}
+}
+
+// sign returns a serialized v1 SCT over an entry of entryType, which addEntry
+// writes into the signed data as RFC 6962 3.2 lays it out.
+func (l testLog) sign(t *testing.T, timestamp time.Time, entryType uint16, addEntry func(*cryptobyte.Builder)) []byte {
+	t.Helper()
+	ts := uint64(timestamp.UnixMilli())
+
+	var signed cryptobyte.Builder
+	signed.AddUint8(0)
+	signed.AddUint8(0)
+	signed.AddUint64(ts)
+	signed.AddUint16(entryType)
+	addEntry(&signed)
+	signed.AddUint16(0)
+	digest := sha256.Sum256(signed.BytesOrPanic())
+
+	signature, err := l.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigAlg := uint8(3)
+	if _, ok := l.key.(*rsa.PrivateKey); ok {
+		sigAlg = 1
+	}
+
+	logID := sha256.Sum256(l.der)
+	var b cryptobyte.Builder
+	b.AddUint8(0)
+	b.AddBytes(logID[:])
+	b.AddUint64(ts)
+	b.AddUint16(0)
+	b.AddUint8(4)
+	b.AddUint8(sigAlg)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(signature) })
+	return b.BytesOrPanic()
+}
+
+// writeLogList writes a v3 log list with each log under its own operator.
+func writeLogList(t *testing.T, logs map[string]testLog) string {
+	t.Helper()
+	type log struct {
+		Description string                     `json:"description"`
+		Key         string                     `json:"key"`
+		State       map[string]json.RawMessage `json:"state"`
+	}
+	type operator struct {
+		Name string `json:"name"`
+		Logs []log  `json:"logs"`
+	}
+	var list struct {
+		Operators []operator `json:"operators"`
+	}
+	for name, l := range logs {
+		list.Operators = append(list.Operators, operator{
+			Name: name,
+			Logs: []log{{Description: "Test log " + name, Key: base64.StdEncoding.EncodeToString(l.der), State: map[string]json.RawMessage{"usable": []byte("{}")}}},
+		})
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "log_list.json")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCheckSCT(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaLog, rsaLog := newTestLog(t, newECDSAKey(t)), newTestLog(t, rsaKey)
+	logs, err := LoadCTLogList(writeLogList(t, map[string]testLog{"ECDSA": ecdsaLog, "RSA": rsaLog}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signedAt := time.Now().Add(-time.Hour)
+
+	// The embedded SCT signs the leaf's TBSCertificate before the SCT list
+	// was added to it, so the leaf is issued twice with the same key.
+	leafKey := newECDSAKey(t)
+	template := leafTemplate(8)
+	precert := ca.issue(t, leafKey, template)
+	embedded := ecdsaLog.sign(t, signedAt, sctPrecertEntry, func(b *cryptobyte.Builder) {
+		issuerKeyHash := sha256.Sum256(ca.cert.RawSubjectPublicKeyInfo)
+		b.AddBytes(issuerKeyHash[:])
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(precert.Leaf.RawTBSCertificate) })
+	})
+	var list cryptobyte.Builder
+	list.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(embedded) })
+	})
+	listExt, err := asn1.Marshal(list.BytesOrPanic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.ExtraExtensions = []pkix.Extension{{Id: oidSCTList, Value: listExt}}
+	cert := ca.issue(t, leafKey, template)
+
+	inTLS := rsaLog.sign(t, signedAt, sctX509Entry, func(b *cryptobyte.Builder) {
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(cert.Leaf.Raw) })
+	})
+	// Moving the timestamp invalidates the signature over it.
+	tampered := append([]byte{}, inTLS...)
+	tampered[1+sha256.Size+7]++
+	v2 := append([]byte{1}, inTLS[1:]...)
+	truncated := inTLS[:len(inTLS)-1]
+
+	tests := []struct {
+		name    string
+		scts    [][]byte
+		code    int
+		value   string
+		details []string
+	}{
+		{"embedded and TLS extension", [][]byte{inTLS}, 0, "SCT okay, 2 valid SCTs of 2 from 2 log operators",
+			[]string{"SCT embedded from Test log ECDSA at ", ": valid\n", "SCT in TLS extension from Test log RSA at "}},
+		{"tampered", [][]byte{tampered}, 2, "SCT critical, 1 valid SCTs of 2 from 1 log operators, at least 2 needed",
+			[]string{"SCT in TLS extension from Test log RSA at ", ": invalid, bad signature\n"}},
+		{"unknown version and malformed skipped", [][]byte{v2, truncated, inTLS}, 0, "SCT okay, 2 valid SCTs of 2 from 2 log operators",
+			[]string{"SCT in TLS extension: skipped, unsupported version v2\n", "SCT in TLS extension: skipped, malformed\n"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			served := cert
+			served.SignedCertificateTimestamps = tt.scts
+			srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{served}}, false, okHandler)
+
+			r := fetchTest(t, srv, ca).CheckSCT(logs, 2)
+			if r.Error != nil {
+				t.Fatalf("CheckSCT: %v", r.Error)
+			}
+			if r.ReturnCode != tt.code || r.Value != tt.value {
+				t.Errorf("CheckSCT = %d %q, want %d %q", r.ReturnCode, r.Value, tt.code, tt.value)
+			}
+			for _, want := range tt.details {
+				if !strings.Contains(r.VerboseValue, want) {
+					t.Errorf("CheckSCT details = %q, want %q", r.VerboseValue, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPrecertTBS(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	key := newECDSAKey(t)
+	template := leafTemplate(9)
+	precert := ca.issue(t, key, template)
+	template.ExtraExtensions = []pkix.Extension{{Id: oidSCTList, Value: []byte{0x04, 0x02, 0x00, 0x00}}}
+	cert := ca.issue(t, key, template)
+
+	tbs, err := precertTBS(cert.Leaf.RawTBSCertificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(tbs) != string(precert.Leaf.RawTBSCertificate) {
+		t.Error("precertTBS doesn't match the TBSCertificate issued without the SCT list")
+	}
+	if _, err := precertTBS([]byte{0x30, 0x05, 0x01}); err == nil {
+		t.Error("precertTBS of a truncated TBSCertificate succeeded, want an error")
+	}
+}
@@ -0,0 +1,275 @@
+package check
+
+import (
+	"crypto/tls"
+	"errors"
+	"math"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimingPhases are the phase names accepted by ParseTimingThresholds
+var TimingPhases = []string{"dns", "connect", "tls", "ttfb", "transfer", "redirects", "total"}
+
+// Timings holds how long each phase of fetching the final response took.
+// When the final request reused a connection, the DNS, connect and TLS phases
+// are those of the request that opened it.
+type Timings struct {
+	DNS       time.Duration // Resolving the host name
+	Connect   time.Duration // Establishing the TCP connection
+	TLS       time.Duration // TLS handshake
+	FirstByte time.Duration // Waiting for the first response byte after sending the request
+	Transfer  time.Duration // Reading the rest of the response
+	Redirects time.Duration // Requests that were redirected, before the final one started
+	Total     time.Duration // The whole fetch, redirects included
+}
+
+// requestTrace records when each phase of one request happened
+type requestTrace struct {
+	url          string
+	status       int
+	spanID       [8]byte // Parent ID sent in the traceparent header, if any
+	start        time.Time
+	dnsStart     time.Time
+	dnsDone      time.Time
+	connectStart time.Time
+	connectDone  time.Time
+	tlsStart     time.Time
+	tlsDone      time.Time
+	wroteRequest time.Time
+	firstByte    time.Time
+	done         time.Time
+}
+
+// clientTrace returns the hooks that fill in t as the request proceeds. Only
+// the first attempt at each phase is kept, so when several addresses are
+// dialled the connect phase runs from the first dial to the first success.
+func (t *requestTrace) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			if t.dnsStart.IsZero() {
+				t.dnsStart = time.Now()
+			}
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			if t.dnsDone.IsZero() {
+				t.dnsDone = time.Now()
+			}
+		},
+		ConnectStart: func(network, addr string) {
+			if t.connectStart.IsZero() {
+				t.connectStart = time.Now()
+			}
+		},
+		ConnectDone: func(network, addr string, err error) {
+			if err == nil && t.connectDone.IsZero() {
+				t.connectDone = time.Now()
+			}
+		},
+		TLSHandshakeStart: func() {
+			if t.tlsStart.IsZero() {
+				t.tlsStart = time.Now()
+			}
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			if t.tlsDone.IsZero() {
+				t.tlsDone = time.Now()
+			}
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			t.wroteRequest = time.Now()
+		},
+		GotFirstResponseByte: func() {
+			t.firstByte = time.Now()
+		},
+	}
+}
+
+// Timings returns the phase timings of the last Fetch, or zero timings if it
+// produced no response.
+func (h *HTTPCheck) Timings() Timings {
+	var t Timings
+	if h.resp == nil || len(h.traces) == 0 {
+		return t
+	}
+
+	last := h.traces[len(h.traces)-1]
+	for i := len(h.traces) - 1; i >= 0; i-- {
+		tr := h.traces[i]
+		if t.DNS == 0 {
+			t.DNS = between(tr.dnsStart, tr.dnsDone)
+		}
+		if t.Connect == 0 {
+			t.Connect = between(tr.connectStart, tr.connectDone)
+		}
+		if t.TLS == 0 {
+			t.TLS = between(tr.tlsStart, tr.tlsDone)
+		}
+	}
+	t.FirstByte = between(last.wroteRequest, last.firstByte)
+	t.Transfer = between(last.firstByte, last.done)
+	t.Redirects = between(h.traces[0].start, last.start)
+	t.Total = between(h.traces[0].start, last.done)
+	return t
+}
+
+// RequestTiming holds when each phase of one request made by Fetch happened.
+// Phases that didn't happen, like connecting when a connection was reused,
+// are zero.
+type RequestTiming struct {
+	URL          string
+	Status       int     // HTTP status code, zero if no response was received
+	SpanID       [8]byte // Parent ID sent in the traceparent header, zero if none was sent
+	Start        time.Time
+	DNSStart     time.Time
+	DNSDone      time.Time
+	ConnectStart time.Time
+	ConnectDone  time.Time
+	TLSStart     time.Time
+	TLSDone      time.Time
+	FirstByte    time.Time
+	Done         time.Time
+}
+
+// RequestTimings returns the phase times of each request made by the last
+// Fetch, redirects first.
+func (h *HTTPCheck) RequestTimings() []RequestTiming {
+	timings := make([]RequestTiming, 0, len(h.traces))
+	for _, t := range h.traces {
+		timings = append(timings, RequestTiming{
+			URL:          t.url,
+			Status:       t.status,
+			SpanID:       t.spanID,
+			Start:        t.start,
+			DNSStart:     t.dnsStart,
+			DNSDone:      t.dnsDone,
+			ConnectStart: t.connectStart,
+			ConnectDone:  t.connectDone,
+			TLSStart:     t.tlsStart,
+			TLSDone:      t.tlsDone,
+			FirstByte:    t.firstByte,
+			Done:         t.done,
+		})
+	}
+	return timings
+}
+
+// ParseTimingThresholds takes a comma-seperated list of phase=range pairs like
+// "total=2s,tls=500ms" or "total=100ms:2s" and returns the ranges by phase, in
+// milliseconds. Range bounds are read by ParseDurationRange, so a bare
+// duration alerts above it. A bare range applies to the total.
+func ParseTimingThresholds(s string) (map[string]Range, error) {
+	thresholds := map[string]Range{}
+	if s == "" {
+		return thresholds, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		phase, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			phase, value = "total", phase
+		}
+		phase = strings.ToLower(phase)
+
+		known := false
+		for _, p := range TimingPhases {
+			known = known || p == phase
+		}
+		if !known {
+			return nil, errors.New("unknown timing phase " + strconv.Quote(phase) + ", expected one of " + strings.Join(TimingPhases, ", "))
+		}
+
+		r, err := ParseDurationRange(value)
+		if err != nil {
+			return nil, errors.New("timing phase " + phase + ": " + err.Error())
+		}
+		thresholds[phase] = r
+	}
+	return thresholds, nil
+}
+
+// CheckTimings function reports how long each phase of fetching the final
+// response took as perfdata, compares each against its warn and crit
+// ranges, and returns the result. Phases without a range are only reported.
+func (h *HTTPCheck) CheckTimings(warn map[string]Range, crit map[string]Range) Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	t := h.Timings()
+	phases := []struct {
+		name     string
+		metric   string
+		duration time.Duration
+	}{
+		{"dns", "time_dns", t.DNS},
+		{"connect", "time_connect", t.Connect},
+		{"tls", "time_tls", t.TLS},
+		{"ttfb", "time_ttfb", t.FirstByte},
+		{"transfer", "time_transfer", t.Transfer},
+		{"redirects", "time_redirects", t.Redirects},
+		{"total", "time_total", t.Total},
+	}
+
+	for _, p := range phases {
+		var levels Thresholds
+		if w, ok := warn[p.name]; ok {
+			levels.Warn = &w
+		}
+		if c, ok := crit[p.name]; ok {
+			levels.Crit = &c
+		}
+
+		zero := 0.0
+		ms := millis(p.duration)
+		if err := h.PerfData.AddMetric(Metric{Label: p.metric, Value: ms, UOM: "ms", Warn: levels.Warn, Crit: levels.Crit, Min: &zero}); err != nil {
+			r.Error = err
+			return r
+		}
+		r.VerboseValue += "Time " + p.name + ": " + formatMillis(p.duration) + "ms\n"
+
+		if breached := levels.Breached(ms); breached != nil {
+			r.Findings = append(r.Findings, Finding{Rule: p.name, ReturnCode: levels.Evaluate(ms), Message: p.name + " took " + formatMillis(p.duration) + "ms, " + breached.Describe(ms, "ms")})
+		}
+	}
+
+	summary := "fetched in " + formatMillis(t.Total) + "ms, first byte after " + formatMillis(t.FirstByte) + "ms"
+	var slow []string
+	for _, f := range r.Findings {
+		r.ReturnCode = WorstReturnCode(r.ReturnCode, f.ReturnCode)
+		slow = append(slow, f.Message)
+	}
+
+	switch r.ReturnCode {
+	case 0:
+		r.Value = "Timing okay, " + summary
+	case 1:
+		r.Value = "Timing warning, " + strings.Join(slow, ", ")
+	default:
+		r.Value = "Timing critical, " + strings.Join(slow, ", ")
+	}
+	return r
+}
+
+// between returns the time from start to end, or zero if either is unset.
+func between(start, end time.Time) time.Duration {
+	if start.IsZero() || end.IsZero() {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// millis returns d in milliseconds, rounded to three decimals.
+func millis(d time.Duration) float64 {
+	return math.Round(float64(d)/float64(time.Microsecond)) / 1000
+}
+
+// formatMillis formats d as milliseconds with three decimals.
+func formatMillis(d time.Duration) string {
+	return strconv.FormatFloat(millis(d), 'f', 3, 64)
+}
@@ -0,0 +1,154 @@
+package check
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net"
+	"reflect"
+
+	"github.com/jeffalyanak/check_https_go/tlsmap"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// LoadCABundle reads a PEM file of CA certificates and returns a pool for
+// RootCAs. The certificates are added to the system roots unless replace is
+// set, in which case they are the only roots trusted.
+func LoadCABundle(path string, replace bool) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !replace {
+		pool, err = x509.SystemCertPool()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("CA bundle: no PEM certificates found in " + path)
+	}
+	return pool, nil
+}
+
+// LoadClientCertificate reads a client certificate for mutual TLS. A PEM
+// certificate is paired with the PEM key in keyFile. A file that isn't PEM is
+// decoded as PKCS#12 using password, and keyFile is ignored.
+func LoadClientCertificate(certFile string, keyFile string, password string) (tls.Certificate, error) {
+	data, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	if block, _ := pem.Decode(data); block != nil {
+		if keyFile == "" {
+			return tls.LoadX509KeyPair(certFile, certFile)
+		}
+		return tls.LoadX509KeyPair(certFile, keyFile)
+	}
+
+	key, leaf, chain, err := pkcs12.DecodeChain(data, password)
+	if err != nil {
+		return tls.Certificate{}, errors.New("client certificate: " + err.Error())
+	}
+
+	cert := tls.Certificate{
+		Certificate: [][]byte{leaf.Raw},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}
+	for _, c := range chain {
+		cert.Certificate = append(cert.Certificate, c.Raw)
+	}
+	return cert, nil
+}
+
+// CheckClientCertificate function reports whether the server asked for the
+// client certificate given in Certificates and returns the result. A server
+// that never requests it is a warning, since the certificate isn't being
+// tested; rejection is reported by Fetch as the request fails.
+func (h *HTTPCheck) CheckClientCertificate() Result {
+	var r Result
+
+	if h.resp == nil {
+		r.Error = errNotFetched
+		return r
+	}
+
+	if len(h.Certificates) == 0 {
+		r.Value = "No client certificate configured"
+		return r
+	}
+
+	subject := "client certificate"
+	if leaf, err := x509.ParseCertificate(h.Certificates[0].Certificate[0]); err == nil {
+		subject = leaf.Subject.String()
+	}
+
+	if !h.clientCertRequested {
+		r.ReturnCode = 1
+		r.Value = "Server did not request a client certificate, " + subject + " was not sent"
+		return r
+	}
+
+	r.ReturnCode = 0
+	r.Value = "Client certificate accepted: " + subject
+	return r
+}
+
+// getClientCertificate is the tls.Config callback for a certificate request.
+// It records that the server asked and offers the configured certificate.
+func (h *HTTPCheck) getClientCertificate(cri *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	h.clientCertRequested = true
+
+	for i := range h.Certificates {
+		if cri.SupportsCertificate(&h.Certificates[i]) == nil {
+			return &h.Certificates[i], nil
+		}
+	}
+	if len(h.Certificates) > 0 {
+		return &h.Certificates[0], nil
+	}
+
+	// Sending no certificate lets the server decide whether it's required.
+	return &tls.Certificate{}, nil
+}
+
+// isRemoteAlert reports whether err is a TLS alert sent by the server.
+func isRemoteAlert(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "remote error"
+}
+
+// remoteAlert names the TLS alert in an error found by isRemoteAlert.
+func remoteAlert(err error) string {
+	var opErr *net.OpError
+	errors.As(err, &opErr)
+
+	// crypto/tls keeps its alert type unexported, but it is a uint8
+	// holding the alert description.
+	v := reflect.ValueOf(opErr.Err)
+	if v.Kind() != reflect.Uint8 {
+		return opErr.Err.Error()
+	}
+	return tlsmap.Alert(uint8(v.Uint()))
+}
+
+// isClientCertRejected reports whether a request failure is the server
+// refusing the client certificate, or the lack of one, after asking for it.
+func (h *HTTPCheck) isClientCertRejected(err error) bool {
+	return h.clientCertRequested && isRemoteAlert(err)
+}
+
+// clientCertRejection describes a failure found by isClientCertRejected.
+func (h *HTTPCheck) clientCertRejection(err error) string {
+	if len(h.Certificates) == 0 {
+		return "Server requires a client certificate (" + remoteAlert(err) + " alert)"
+	}
+	return "Server rejected the client certificate (" + remoteAlert(err) + " alert)"
+}
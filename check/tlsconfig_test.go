@@ -0,0 +1,137 @@
+package check
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// clientTemplate returns a client certificate template, which issue fills in.
+func clientTemplate(serial int64) *x509.Certificate {
+	template := leafTemplate(serial)
+	template.Subject = pkix.Name{CommonName: "check_https_go client"}
+	template.DNSNames, template.IPAddresses = nil, nil
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	return template
+}
+
+// writeFile writes data to name in dir and returns its path.
+func writeFile(t *testing.T, dir string, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadClientCertificate(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), clientTemplate(40))
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	p12, err := pkcs12.Modern.Encode(cert.PrivateKey, cert.Leaf, []*x509.Certificate{ca.cert}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	certFile := writeFile(t, dir, "client.crt", certPEM)
+	keyFile := writeFile(t, dir, "client.key", keyPEM)
+	bothFile := writeFile(t, dir, "client.pem", append(append([]byte{}, certPEM...), keyPEM...))
+	p12File := writeFile(t, dir, "client.p12", p12)
+
+	tests := []struct {
+		name     string
+		certFile string
+		keyFile  string
+		password string
+		chain    int
+	}{
+		{"PEM with key file", certFile, keyFile, "", 1},
+		{"PEM with key in the same file", bothFile, "", "", 1},
+		{"PKCS#12", p12File, "ignored.key", "secret", 2},
+	}
+	for _, tt := range tests {
+		got, err := LoadClientCertificate(tt.certFile, tt.keyFile, tt.password)
+		if err != nil {
+			t.Errorf("%s: LoadClientCertificate: %v", tt.name, err)
+			continue
+		}
+		if len(got.Certificate) != tt.chain || string(got.Certificate[0]) != string(cert.Certificate[0]) {
+			t.Errorf("%s: LoadClientCertificate returned %d certificates, want %d starting with the client certificate", tt.name, len(got.Certificate), tt.chain)
+		}
+		if got.PrivateKey == nil {
+			t.Errorf("%s: LoadClientCertificate returned no private key", tt.name)
+		}
+	}
+
+	if _, err := LoadClientCertificate(p12File, "", "wrong"); err == nil || !strings.HasPrefix(err.Error(), "client certificate: ") {
+		t.Errorf("LoadClientCertificate with the wrong password = %v, want a client certificate error", err)
+	}
+	if _, err := LoadClientCertificate(certFile, "", ""); err == nil {
+		t.Error("LoadClientCertificate of a PEM certificate without its key succeeded, want an error")
+	}
+}
+
+func TestClientCertificate(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	clientCA, otherCA := newTestCA(t, newECDSAKey(t)), newTestCA(t, newECDSAKey(t))
+	serverCert := ca.issue(t, newECDSAKey(t), leafTemplate(41))
+	accepted := clientCA.issue(t, newECDSAKey(t), clientTemplate(42))
+	rejected := otherCA.issue(t, newECDSAKey(t), clientTemplate(43))
+
+	tests := []struct {
+		name       string
+		clientAuth tls.ClientAuthType
+		certs      []tls.Certificate
+		fetchCode  int
+		fetchValue string
+		code       int
+		value      string
+	}{
+		{"accepted", tls.RequireAndVerifyClientCert, []tls.Certificate{accepted}, 0, "", 0, "Client certificate accepted: CN=check_https_go client"},
+		{"rejected", tls.RequireAndVerifyClientCert, []tls.Certificate{rejected}, 2, "Server rejected the client certificate (unknown_ca alert)", 0, ""},
+		{"required", tls.RequireAndVerifyClientCert, nil, 2, "Server requires a client certificate (certificate_required alert)", 0, ""},
+		{"not requested", tls.NoClientCert, []tls.Certificate{accepted}, 0, "", 1, "Server did not request a client certificate, CN=check_https_go client was not sent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTLSServer(t, &tls.Config{
+				Certificates: []tls.Certificate{serverCert},
+				ClientAuth:   tt.clientAuth,
+				ClientCAs:    clientCA.pool,
+			}, false, okHandler)
+
+			h := &HTTPCheck{URL: strings.TrimPrefix(srv.URL, "https://"), RootCAs: ca.pool, Certificates: tt.certs}
+			fetched := h.Fetch(0, "check_https_go test", 5)
+			if fetched.Error != nil {
+				t.Fatalf("Fetch: %v", fetched.Error)
+			}
+			if fetched.ReturnCode != tt.fetchCode || (tt.fetchCode != 0 && fetched.Value != tt.fetchValue) {
+				t.Fatalf("Fetch = %d %q, want %d %q", fetched.ReturnCode, fetched.Value, tt.fetchCode, tt.fetchValue)
+			}
+			if tt.fetchCode != 0 {
+				return
+			}
+
+			r := h.CheckClientCertificate()
+			if r.Error != nil || r.ReturnCode != tt.code || r.Value != tt.value {
+				t.Errorf("CheckClientCertificate = %d %q, %v, want %d %q", r.ReturnCode, r.Value, r.Error, tt.code, tt.value)
+			}
+		})
+	}
+}
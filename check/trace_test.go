@@ -0,0 +1,58 @@
+package check
+
+import (
+	"crypto/tls"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestTraceparentPropagation(t *testing.T) {
+	ca := newTestCA(t, newECDSAKey(t))
+	cert := ca.issue(t, newECDSAKey(t), leafTemplate(7))
+
+	// Records the traceparent header of every request, over TCP and QUIC
+	var mu sync.Mutex
+	var headers []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Proto+" "+r.Header.Get("traceparent"))
+		mu.Unlock()
+	}
+
+	port := newHTTP3Server(t, cert, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		record(w, r)
+		okHandler(w, r)
+	}))
+	srv := newTLSServer(t, &tls.Config{Certificates: []tls.Certificate{cert}}, true, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		record(w, r)
+		w.Header().Set("Alt-Svc", `h3=":`+strconv.Itoa(port)+`"`)
+		okHandler(w, r)
+	}))
+
+	h := &HTTPCheck{URL: strings.TrimPrefix(srv.URL, "https://"), RootCAs: ca.pool, TraceID: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}}
+	if r := h.Fetch(0, "check_https_go test", 5); r.Error != nil {
+		t.Fatalf("Fetch: %v", r.Error)
+	}
+	if r := h.CheckEachHTTPVersion("200", "hello"); r.Error != nil || r.ReturnCode != 0 {
+		t.Fatalf("CheckEachHTTPVersion = %d %q, %v", r.ReturnCode, r.Value, r.Error)
+	}
+	if r := h.CheckHTTP3("200", "hello", Thresholds{}); r.Error != nil || r.ReturnCode != 0 {
+		t.Fatalf("CheckHTTP3 = %d %q, %v", r.ReturnCode, r.Value, r.Error)
+	}
+
+	// The fetch, HTTP/1.1, HTTP/2, HTTP/3 and the TCP comparison
+	if len(headers) != 5 {
+		t.Fatalf("server saw %d requests, want 5: %q", len(headers), headers)
+	}
+	prefix := "00-" + hex.EncodeToString(h.TraceID[:]) + "-"
+	for _, header := range headers {
+		_, value, _ := strings.Cut(header, " ")
+		if !strings.HasPrefix(value, prefix) || len(value) != len(prefix)+16+3 {
+			t.Errorf("%s request has traceparent %q, want one in trace %s", strings.Fields(header)[0], value, hex.EncodeToString(h.TraceID[:]))
+		}
+	}
+}
@@ -1,3 +1,16 @@
 module github.com/jeffalyanak/check_https_go
 
-go 1.17
+go 1.26.0
+
+require (
+	github.com/quic-go/quic-go v0.63.0
+	golang.org/x/crypto v0.54.0
+	software.sslmate.com/src/go-pkcs12 v0.7.3
+)
+
+require (
+	github.com/quic-go/qpack v0.6.0 // indirect
+	golang.org/x/net v0.56.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+)
@@ -1,10 +1,10 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
-	"regexp"
 	"strconv"
 	"time"
 
@@ -12,128 +12,203 @@ import (
 )
 
 func main() {
-	// Handle cli arguments
+	// Handle cli arguments, the check options are collected into a module
+	d := defaultModule()
+	var m module
 	host := flag.String("h", "", "Fully-qualified domain name to check.")
-	checkString := flag.String("s", "<!DOCTYPE HTML>", "Custom string to check for in the response body.")
-	userAgent := flag.String("u", "check_https_go", "Custom user-agent string.")
+	flag.StringVar(&m.String, "s", d.String, "Custom string to check for in the response body.")
+	flag.StringVar(&m.UserAgent, "u", d.UserAgent, "Custom user-agent string.")
 	verbose := flag.Bool("v", false, "More verbose output includes details of any redirects.")
-	redirects := flag.Int("r", 20, "Number of redirects to follow.")
-	certwarn := flag.Int("w", 10, "Number of days for which the TLS certificate must be valid before a warning state is returned.")
-	certcrit := flag.Int("c", 5, "Number of days for which the TLS certificate must be valid before a critical state is returned.")
-	timeoutduration := flag.Int("t", 30, "Timeout length in seconds, requests that do not finish before timeout are considered failed.")
-	statusCodes := flag.String("a", "200,201,202,203,204,205,206,207,208,226", "Comma-seperated list of status codes.")
+	flag.IntVar(&m.Redirects, "r", d.Redirects, "Number of redirects to follow.")
+	flag.StringVar(&m.Warn, "w", d.Warn, "Number of days for which every certificate in the chain must be valid before a warning state is returned, or a Nagios range of days left.")
+	flag.StringVar(&m.Crit, "c", d.Crit, "Number of days for which every certificate in the chain must be valid before a critical state is returned, or a Nagios range of days left.")
+	flag.IntVar(&m.Timeout, "t", d.Timeout, "Timeout length in seconds, requests that do not finish before timeout are considered failed.")
+	flag.StringVar(&m.TimeWarn, "time-warn", d.TimeWarn, "Comma-seperated list of phase=range response times outside which a warning is returned, like total=2s,tls=500ms or total=100ms:2s. Phases are dns, connect, tls, ttfb, transfer, redirects and total.")
+	flag.StringVar(&m.TimeCrit, "time-crit", d.TimeCrit, "Comma-seperated list of phase=range response times outside which a critical state is returned, like total=5s.")
+	flag.StringVar(&m.SizeWarn, "size-warn", d.SizeWarn, "Nagios range of response body sizes in bytes outside which a warning is returned, like 1024: for at least 1KB.")
+	flag.StringVar(&m.SizeCrit, "size-crit", d.SizeCrit, "Nagios range of response body sizes in bytes outside which a critical state is returned.")
+	flag.StringVar(&m.RedirectsWarn, "redirects-warn", d.RedirectsWarn, "Nagios range of redirect counts outside which a warning is returned, like 2 for at most two.")
+	flag.StringVar(&m.RedirectsCrit, "redirects-crit", d.RedirectsCrit, "Nagios range of redirect counts outside which a critical state is returned.")
+	flag.StringVar(&m.CA, "ca", d.CA, "PEM file of CA certificates to trust in addition to the system roots.")
+	flag.BoolVar(&m.CAOnly, "ca-only", d.CAOnly, "Trust only the certificates from -ca, not the system roots.")
+	flag.StringVar(&m.Cert, "cert", d.Cert, "Client certificate for mutual TLS, PEM or PKCS#12.")
+	flag.StringVar(&m.Key, "key", d.Key, "PEM private key for the client certificate, if not in the -cert file.")
+	flag.StringVar(&m.CertPass, "cert-pass", d.CertPass, "Password for a PKCS#12 client certificate.")
+	flag.StringVar(&m.Pin, "pin", d.Pin, "Comma-seperated list of SHA-256 certificate fingerprints, one of which must match the leaf or a chain certificate.")
+	flag.StringVar(&m.PinSPKI, "pin-spki", d.PinSPKI, "Comma-seperated list of base64 SHA-256 public key pins, one of which must match the leaf or a chain certificate.")
+	flag.BoolVar(&m.OCSP, "ocsp", d.OCSP, "Check the revocation status of the certificate in the stapled OCSP response.")
+	flag.BoolVar(&m.OCSPQuery, "ocsp-query", d.OCSPQuery, "Query the certificate's OCSP responder when no response was stapled, implies -ocsp.")
+	flag.BoolVar(&m.MustStaple, "must-staple", d.MustStaple, "Critical if the certificate is must-staple but no OCSP response was stapled, implies -ocsp.")
+	flag.BoolVar(&m.CRL, "crl", d.CRL, "Check the certificate against the CRLs in its CRL distribution points.")
+	flag.StringVar(&m.CRLCache, "crl-cache", d.CRLCache, "Directory to cache CRLs in until their next update, empty to disable.")
+	flag.StringVar(&m.CTLogs, "ct-logs", d.CTLogs, "Log list JSON file (v3 schema) of trusted Certificate Transparency logs, enables the SCT check.")
+	flag.IntVar(&m.CTMin, "ct-min", d.CTMin, "Number of distinct log operators that must have issued a valid SCT.")
+	flag.BoolVar(&m.Policy, "policy", d.Policy, "Check the leaf certificate's key, signature algorithm, lifetime, SAN coverage and start date.")
+	flag.IntVar(&m.PolicyRSABits, "policy-rsa-bits", d.PolicyRSABits, "Smallest RSA key size allowed by -policy.")
+	flag.StringVar(&m.PolicyCurves, "policy-curves", d.PolicyCurves, "Comma-seperated list of EC curves allowed by -policy.")
+	flag.StringVar(&m.PolicySigAlgs, "policy-sig-algs", d.PolicySigAlgs, "Comma-seperated list of hashes forbidden in the signature algorithm by -policy.")
+	flag.IntVar(&m.PolicyMaxDays, "policy-max-days", d.PolicyMaxDays, "Longest certificate validity period in days allowed by -policy, 0 for no limit.")
+	flag.StringVar(&m.Issuer, "issuer", d.Issuer, "Text the issuer DN of the certificate must contain.")
+	flag.StringVar(&m.AKI, "aki", d.AKI, "Hex authority key identifier the certificate must have.")
+	flag.StringVar(&m.RootSHA256, "root-sha256", d.RootSHA256, "SHA-256 fingerprint of the root the chain must be verified to.")
+	flag.StringVar(&m.SAN, "san", d.SAN, "Comma-seperated list of names the certificate's subject alternative names must include.")
+	flag.StringVar(&m.Org, "org", d.Org, "Organisation the certificate's subject must name.")
+	flag.BoolVar(&m.Protocols, "protocols", d.Protocols, "Scan which protocol versions from SSL 3.0 to TLS 1.3 the server accepts.")
+	flag.StringVar(&m.MinTLS, "min-tls", d.MinTLS, "Oldest protocol version that may be enabled, older ones are a warning with -protocols.")
+	flag.BoolVar(&m.Ciphers, "ciphers", d.Ciphers, "Enumerate the cipher suites the server accepts, insecure ones are critical.")
+	flag.BoolVar(&m.CiphersWarnWeak, "ciphers-warn-weak", d.CiphersWarnWeak, "Weak cipher suites, without forward secrecy or AEAD, are a warning with -ciphers.")
+	flag.BoolVar(&m.Grade, "grade", d.Grade, "Grade the TLS configuration from A+ to F, scanning protocols and cipher suites.")
+	flag.StringVar(&m.GradeWarn, "grade-warn", d.GradeWarn, "Grades below this are a warning with -grade.")
+	flag.StringVar(&m.GradeCrit, "grade-crit", d.GradeCrit, "Grades below this are critical with -grade.")
+	flag.BoolVar(&m.ALPN, "alpn", d.ALPN, "Report the protocol negotiated over ALPN.")
+	flag.BoolVar(&m.RequireH2, "require-h2", d.RequireH2, "Critical unless HTTP/2 is negotiated over ALPN, implies -alpn.")
+	flag.BoolVar(&m.EachHTTP, "each-http", d.EachHTTP, "Fetch the page again over HTTP/1.1 and HTTP/2 separately and run the status and content checks on each.")
+	flag.BoolVar(&m.HTTP3, "http3", d.HTTP3, "Request the page over HTTP/3 from the endpoint advertised in Alt-Svc and run the status, content and certificate checks on it.")
+	flag.BoolVar(&m.PQ, "pq", d.PQ, "Report the negotiated key exchange group and test which hybrid ML-KEM groups the server accepts.")
+	flag.BoolVar(&m.PQWarnClassical, "pq-warn-classical", d.PQWarnClassical, "A classical key exchange group being negotiated is a warning, implies -pq.")
+	flag.StringVar(&m.OTLPEndpoint, "otlp-endpoint", d.OTLPEndpoint, "OTLP/HTTP collector to send a trace and metrics of each run to, like http://localhost:4318.")
+	output := flag.String("output", "text", "Output format, text for Icinga/Nagios or json for a document following the schema in the README.")
+	listen := flag.String("listen", "", "Address to serve Prometheus probes on, like :9115, instead of checking a single host.")
+	modulesFile := flag.String("modules", "", "JSON file of named modules for -listen, the flags above make up the default module if not given.")
+	flag.StringVar(&m.StatusCodes, "a", d.StatusCodes, "Comma-seperated list of status codes.")
 
 	flag.Parse()
 
+	if *output != "text" && *output != "json" {
+		fmt.Println("Output must be text or json.")
+		os.Exit(3)
+	}
+
+	// Serve probes until killed rather than checking a single host
+	if *listen != "" {
+		modules := map[string]module{"default": m}
+		if *modulesFile != "" {
+			loaded, err := loadModules(*modulesFile)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(3)
+			}
+			modules = loaded
+		}
+
+		err := serveProbes(*listen, modules)
+		fmt.Println(err)
+		os.Exit(3)
+	}
+
 	if *host == "" {
 		fmt.Println("Please provide a fully-qualified domain name.")
 		os.Exit(3)
 	}
 
-	regex := regexp.MustCompile(`^\d+(,\d+)*$`)
-	if !regex.MatchString(*statusCodes) {
-		fmt.Println("Status Codes must be provided as a comma-seperated string. Eg: 200,201,202")
+	p, err := m.prepare()
+	if err != nil {
+		fmt.Println(err)
 		os.Exit(3)
 	}
 
-	// Primary var for the checks
-	var h check.HTTPCheck
-	h.URL = *host
-
-	// Create mew Performance Data struct and start the timer
-	var perfData check.PerfData
-	perfData.StartTimer(time.Now())
-
-	// Perform status code check, exit with additional info if error
-	statuscodeResult := h.CheckStatus(*redirects, *userAgent, *timeoutduration, *statusCodes)
-	if statuscodeResult.Error != nil {
-		printIntro("Status Code Error", h.URL)
-		fmt.Println(statuscodeResult.Error)
-		if *verbose {
-			printVerboseInfo(statuscodeResult.VerboseValue)
-		}
-		fmt.Println(perfData.Get())
-		os.Exit(3)
+	// In JSON mode every check is run and reported, whatever the outcome
+	if *output == "json" {
+		report := p.runAll(*host)
+		printJSON(report)
+		os.Exit(report.ExitCode)
 	}
 
-	// Check return code, exit with additional info if non-zero
-	if statuscodeResult.ReturnCode != 0 {
-		printIntro("Status Code Error", h.URL)
-		printStatusCode(statuscodeResult.Status, statuscodeResult.Value, *statusCodes)
-		if *verbose {
-			printVerboseInfo(statuscodeResult.VerboseValue)
-		}
-		fmt.Println(perfData.Get())
-		os.Exit(statuscodeResult.ReturnCode)
+	// Primary var for the checks
+	h := p.newCheck(*host)
+
+	// Start the timer for the Performance Data
+	start := time.Now()
+	h.PerfData.StartTimer(start)
+
+	// Each check run is exported over OTLP, if enabled, before exiting
+	var runs []checkRun
+	exit := func(code int) {
+		p.export(h, start, runs)
+		os.Exit(code)
 	}
 
-	// Perform content check, exit with additional info if error
-	contentResult := h.CheckContent(*checkString)
-	if contentResult.Error != nil {
-		printIntro("Web Content Error", h.URL)
-		fmt.Println(statuscodeResult.Error)
+	// Fetch the page once, exit with additional info if error
+	fetchResult := h.Fetch(m.Redirects, m.UserAgent, m.Timeout)
+	if fetchResult.Error != nil || fetchResult.ReturnCode != 0 {
+		runs = append(runs, checkRun{"Connection", fetchResult, start, time.Now()})
+	}
+	if fetchResult.Error != nil {
+		printIntro("Connection Error", h.URL)
+		fmt.Println(fetchResult.Error)
 		if *verbose {
-			printVerboseInfo(statuscodeResult.VerboseValue +
-				contentResult.VerboseValue)
+			printVerboseInfo(fetchResult.VerboseValue)
 		}
-		fmt.Println(perfData.Get())
-		os.Exit(3)
+		fmt.Println(h.PerfData.Get())
+		exit(3)
 	}
 
 	// Check return code, exit with additional info if non-zero
-	if contentResult.ReturnCode != 0 {
-		printIntro("Web Content Error", h.URL)
-		printContentCheck(contentResult.Value)
+	if fetchResult.ReturnCode != 0 {
+		printIntro("Connection Error", h.URL)
+		fmt.Println(fetchResult.Value)
 		if *verbose {
-			printVerboseInfo(statuscodeResult.VerboseValue +
-				contentResult.VerboseValue)
+			printVerboseInfo(fetchResult.VerboseValue)
 		}
-		fmt.Println(perfData.Get())
-		os.Exit(contentResult.ReturnCode)
+		fmt.Println(h.PerfData.Get())
+		exit(fetchResult.ReturnCode)
 	}
 
-	// Perform content check, exit with additional info if error
-	certResult := h.CheckCertificate(*certwarn, *certcrit)
-	if certResult.Error != nil {
-		printIntro("TLS Certificate Error", h.URL)
-		fmt.Println(statuscodeResult.Error)
-		if *verbose {
-			printVerboseInfo(statuscodeResult.VerboseValue +
-				contentResult.VerboseValue +
-				certResult.VerboseValue)
+	// Sub-checks run in order against the fetched response
+	checks := p.checks(h)
+
+	// Run each check, exit with additional info on the first error or non-zero return code
+	var results []check.Result
+	verboseInfo := ""
+	for _, c := range checks {
+		began := time.Now()
+		r := c.run()
+		runs = append(runs, checkRun{c.name, r, began, time.Now()})
+		verboseInfo += r.VerboseValue
+
+		if r.Error != nil {
+			printIntro(c.name+" Error", h.URL)
+			fmt.Println(r.Error)
+			if *verbose {
+				printVerboseInfo(verboseInfo)
+			}
+			fmt.Println(h.PerfData.Get())
+			exit(3)
 		}
-		fmt.Println(perfData.Get())
-		os.Exit(3)
-	}
 
-	// Check return code, exit with additional info if non-zero
-	if certResult.ReturnCode != 0 {
-		printIntro("TLS Certificate Error", h.URL)
-		printCertCheck(certResult.Value)
-		if *verbose {
-			printVerboseInfo(statuscodeResult.VerboseValue +
-				contentResult.VerboseValue +
-				certResult.VerboseValue)
+		if r.ReturnCode != 0 {
+			printIntro(c.name+" Error", h.URL)
+			c.print(r)
+			if *verbose {
+				printVerboseInfo(verboseInfo)
+			}
+			fmt.Println(h.PerfData.Get())
+			exit(r.ReturnCode)
 		}
-		fmt.Println(perfData.Get())
-		os.Exit(certResult.ReturnCode)
+
+		results = append(results, r)
 	}
 
 	// Print basic info about the checks
 	printIntro("OK", h.URL)
-	printStatusCode(statuscodeResult.Status, statuscodeResult.Value, *statusCodes)
-	printContentCheck(contentResult.Value)
-	printCertCheck(certResult.Value)
+	for i, c := range checks {
+		c.print(results[i])
+	}
 
 	// Print verbose info if enabled
 	if *verbose {
-		printVerboseInfo(statuscodeResult.VerboseValue +
-			contentResult.VerboseValue +
-			certResult.VerboseValue)
+		printVerboseInfo(verboseInfo)
 	}
 
-	fmt.Println(perfData.Get())
-	os.Exit(0)
+	fmt.Println(h.PerfData.Get())
+	exit(0)
+}
+
+// subCheck is a named check along with the function printing its result
+type subCheck struct {
+	name  string
+	run   func() check.Result
+	print func(check.Result)
 }
 
 func printIntro(issue string, url string) {
@@ -148,10 +223,88 @@ func printStatusCode(status int, value string, expected string) {
 	fmt.Println("Status Code: " + strconv.Itoa(status) + " " + value + ", expected one of: " + expected)
 }
 
+func printChainCheck(value string) {
+	fmt.Println("Chain Check: " + value)
+}
+
 func printCertCheck(value string) {
 	fmt.Println("Cert Check: " + value)
 }
 
+func printTimingCheck(value string) {
+	fmt.Println("Timing Check: " + value)
+}
+
+func printResponseCheck(value string) {
+	fmt.Println("Response Check: " + value)
+}
+
+func printPinCheck(value string) {
+	fmt.Println("Pin Check: " + value)
+}
+
+func printOCSPCheck(value string) {
+	fmt.Println("OCSP Check: " + value)
+}
+
+func printCRLCheck(value string) {
+	fmt.Println("CRL Check: " + value)
+}
+
+func printSCTCheck(value string) {
+	fmt.Println("SCT Check: " + value)
+}
+
+func printPolicyCheck(value string) {
+	fmt.Println("Policy Check: " + value)
+}
+
+func printExpectationsCheck(value string) {
+	fmt.Println("Expectations Check: " + value)
+}
+
+func printALPNCheck(value string) {
+	fmt.Println("ALPN Check: " + value)
+}
+
+func printHTTPVersionsCheck(value string) {
+	fmt.Println("HTTP Versions Check: " + value)
+}
+
+func printProtocolCheck(value string) {
+	fmt.Println("Protocol Check: " + value)
+}
+
+func printCipherCheck(value string) {
+	fmt.Println("Cipher Check: " + value)
+}
+
+func printGradeCheck(value string) {
+	fmt.Println("Grade Check: " + value)
+}
+
+func printHTTP3Check(value string) {
+	fmt.Println("HTTP/3 Check: " + value)
+}
+
+func printPQCheck(value string) {
+	fmt.Println("Post-Quantum Check: " + value)
+}
+
+func printClientCertCheck(value string) {
+	fmt.Println("Client Cert Check: " + value)
+}
+
+func printJSON(report check.Report) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		fmt.Println(err)
+		os.Exit(3)
+	}
+}
+
 func printVerboseInfo(contents string) {
 	if contents != "" {
 		fmt.Println("\nAdditional info:\n" + contents)
@@ -0,0 +1,357 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jeffalyanak/check_https_go/check"
+)
+
+// module holds the options for one way of checking a host. The command line
+// flags fill in one module, and the probe server reads named modules from its
+// config file, with the JSON names below and the flags' defaults.
+type module struct {
+	StatusCodes     string `json:"status_codes"`
+	String          string `json:"string"`
+	UserAgent       string `json:"user_agent"`
+	Redirects       int    `json:"redirects"`
+	Timeout         int    `json:"timeout"`
+	Warn            string `json:"warn"`
+	Crit            string `json:"crit"`
+	TimeWarn        string `json:"time_warn"`
+	TimeCrit        string `json:"time_crit"`
+	SizeWarn        string `json:"size_warn"`
+	SizeCrit        string `json:"size_crit"`
+	RedirectsWarn   string `json:"redirects_warn"`
+	RedirectsCrit   string `json:"redirects_crit"`
+	CA              string `json:"ca"`
+	CAOnly          bool   `json:"ca_only"`
+	Cert            string `json:"cert"`
+	Key             string `json:"key"`
+	CertPass        string `json:"cert_pass"`
+	Pin             string `json:"pin"`
+	PinSPKI         string `json:"pin_spki"`
+	OCSP            bool   `json:"ocsp"`
+	OCSPQuery       bool   `json:"ocsp_query"`
+	MustStaple      bool   `json:"must_staple"`
+	CRL             bool   `json:"crl"`
+	CRLCache        string `json:"crl_cache"`
+	CTLogs          string `json:"ct_logs"`
+	CTMin           int    `json:"ct_min"`
+	Policy          bool   `json:"policy"`
+	PolicyRSABits   int    `json:"policy_rsa_bits"`
+	PolicyCurves    string `json:"policy_curves"`
+	PolicySigAlgs   string `json:"policy_sig_algs"`
+	PolicyMaxDays   int    `json:"policy_max_days"`
+	Issuer          string `json:"issuer"`
+	AKI             string `json:"aki"`
+	RootSHA256      string `json:"root_sha256"`
+	SAN             string `json:"san"`
+	Org             string `json:"org"`
+	Protocols       bool   `json:"protocols"`
+	MinTLS          string `json:"min_tls"`
+	Ciphers         bool   `json:"ciphers"`
+	CiphersWarnWeak bool   `json:"ciphers_warn_weak"`
+	Grade           bool   `json:"grade"`
+	GradeWarn       string `json:"grade_warn"`
+	GradeCrit       string `json:"grade_crit"`
+	ALPN            bool   `json:"alpn"`
+	RequireH2       bool   `json:"require_h2"`
+	EachHTTP        bool   `json:"each_http"`
+	HTTP3           bool   `json:"http3"`
+	PQ              bool   `json:"pq"`
+	PQWarnClassical bool   `json:"pq_warn_classical"`
+	OTLPEndpoint    string `json:"otlp_endpoint"`
+}
+
+// defaultModule returns a module with every option at its default
+func defaultModule() module {
+	policy := check.DefaultPolicy()
+	return module{
+		StatusCodes:   "200,201,202,203,204,205,206,207,208,226",
+		String:        "<!DOCTYPE HTML>",
+		UserAgent:     "check_https_go",
+		Redirects:     20,
+		Timeout:       30,
+		Warn:          "10",
+		Crit:          "5",
+		CRLCache:      check.DefaultCRLCacheDir(),
+		CTMin:         2,
+		PolicyRSABits: policy.MinRSABits,
+		PolicyCurves:  strings.Join(policy.AllowedCurves, ","),
+		PolicySigAlgs: strings.Join(policy.ForbiddenSigAlgs, ","),
+		PolicyMaxDays: policy.MaxValidityDays,
+		MinTLS:        "1.2",
+		GradeWarn:     "A-",
+		GradeCrit:     "B",
+	}
+}
+
+// loadModules reads a config file of named modules, like
+// {"modules": {"default": {"warn": "30"}}}. Options left out of a module keep
+// their defaults.
+func loadModules(path string) (map[string]module, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.New("modules: " + err.Error())
+	}
+
+	var config struct {
+		Modules map[string]json.RawMessage `json:"modules"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, errors.New("modules: " + path + ": " + err.Error())
+	}
+
+	modules := map[string]module{}
+	for name, raw := range config.Modules {
+		m := defaultModule()
+		dec := json.NewDecoder(strings.NewReader(string(raw)))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&m); err != nil {
+			return nil, errors.New("modules: " + path + ": module " + strconv.Quote(name) + ": " + err.Error())
+		}
+		modules[name] = m
+	}
+	return modules, nil
+}
+
+// preparedModule is a module with its options parsed and its files loaded,
+// ready to check any number of hosts.
+type preparedModule struct {
+	module
+	certLevels      check.Thresholds
+	sizeLevels      check.Thresholds
+	redirectsLevels check.Thresholds
+	timeWarnLevels  map[string]check.Range
+	timeCritLevels  map[string]check.Range
+	certPins        []string
+	spkiPins        []string
+	logs            *check.CTLogList
+	policy          check.Policy
+	minVersion      uint16
+	gradeWarnLevel  check.Grade
+	gradeCritLevel  check.Grade
+	rootCAs         *x509.CertPool
+	certificates    []tls.Certificate
+	expect          check.Expectations
+}
+
+// prepare parses the module's options and loads the files it names.
+func (m module) prepare() (*preparedModule, error) {
+	p := &preparedModule{module: m}
+	var err error
+
+	regex := regexp.MustCompile(`^\d+(,\d+)*$`)
+	if !regex.MatchString(m.StatusCodes) {
+		return nil, errors.New("Status Codes must be provided as a comma-seperated string. Eg: 200,201,202")
+	}
+
+	if p.certPins, err = check.ParseCertPins(m.Pin); err != nil {
+		return nil, err
+	}
+	if p.spkiPins, err = check.ParseSPKIPins(m.PinSPKI); err != nil {
+		return nil, err
+	}
+
+	if m.CTLogs != "" {
+		if p.logs, err = check.LoadCTLogList(m.CTLogs); err != nil {
+			return nil, err
+		}
+	}
+
+	p.policy = check.Policy{
+		MinRSABits:       m.PolicyRSABits,
+		AllowedCurves:    strings.Split(m.PolicyCurves, ","),
+		ForbiddenSigAlgs: strings.Split(m.PolicySigAlgs, ","),
+		MaxValidityDays:  m.PolicyMaxDays,
+	}
+
+	if p.minVersion, err = check.ParseTLSVersion(m.MinTLS); err != nil {
+		return nil, err
+	}
+	if p.gradeWarnLevel, err = check.ParseGrade(m.GradeWarn); err != nil {
+		return nil, err
+	}
+	if p.gradeCritLevel, err = check.ParseGrade(m.GradeCrit); err != nil {
+		return nil, err
+	}
+
+	if p.certLevels, err = check.ParseThresholds(m.Warn, m.Crit, check.ParseDaysRange); err != nil {
+		return nil, err
+	}
+	if p.sizeLevels, err = check.ParseThresholds(m.SizeWarn, m.SizeCrit, check.ParseRange); err != nil {
+		return nil, err
+	}
+	if p.redirectsLevels, err = check.ParseThresholds(m.RedirectsWarn, m.RedirectsCrit, check.ParseRange); err != nil {
+		return nil, err
+	}
+	if p.timeWarnLevels, err = check.ParseTimingThresholds(m.TimeWarn); err != nil {
+		return nil, err
+	}
+	if p.timeCritLevels, err = check.ParseTimingThresholds(m.TimeCrit); err != nil {
+		return nil, err
+	}
+
+	if m.CA != "" {
+		if p.rootCAs, err = check.LoadCABundle(m.CA, m.CAOnly); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.Cert != "" {
+		cert, err := check.LoadClientCertificate(m.Cert, m.Key, m.CertPass)
+		if err != nil {
+			return nil, err
+		}
+		p.certificates = append(p.certificates, cert)
+	}
+
+	p.expect = check.Expectations{
+		Issuer:          m.Issuer,
+		AuthorityKeyID:  m.AKI,
+		RootFingerprint: m.RootSHA256,
+		Organization:    m.Org,
+	}
+	if m.SAN != "" {
+		p.expect.SANs = strings.Split(m.SAN, ",")
+	}
+
+	return p, nil
+}
+
+// newCheck returns an HTTPCheck of host set up with the module's trust
+// anchors, client certificates and expectations.
+func (p *preparedModule) newCheck(host string) *check.HTTPCheck {
+	h := &check.HTTPCheck{
+		URL:          host,
+		RootCAs:      p.rootCAs,
+		Certificates: p.certificates,
+		Expect:       p.expect,
+	}
+	if p.OTLPEndpoint != "" {
+		h.TraceID = newTraceID()
+	}
+	return h
+}
+
+// export sends the trace and metrics of a run of the checks to the module's
+// OTLP endpoint, if it has one. A failed export is reported on stderr rather
+// than changing the outcome of the checks.
+func (p *preparedModule) export(h *check.HTTPCheck, start time.Time, runs []checkRun) {
+	if p.OTLPEndpoint == "" {
+		return
+	}
+	if err := exportOTLP(p.OTLPEndpoint, h, start, runs); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+// checks returns the sub-checks the module enables, to run in order against
+// h once it has been fetched.
+func (p *preparedModule) checks(h *check.HTTPCheck) []subCheck {
+	// Fetch doesn't verify the chain itself, so it is checked first and the
+	// status and content are marked when they came over a connection it
+	// didn't verify.
+	verified := false
+	checks := []subCheck{
+		{"TLS Chain", func() check.Result {
+			r := h.CheckChain()
+			verified = r.Error == nil && r.ReturnCode == 0
+			return r
+		}, func(r check.Result) { printChainCheck(r.Value) }},
+		{"Status Code", func() check.Result { return markUnverified(h.CheckStatus(p.StatusCodes), verified) }, func(r check.Result) { printStatusCode(r.Status, r.Value, p.StatusCodes) }},
+		{"Web Content", func() check.Result { return markUnverified(h.CheckContent(p.String), verified) }, func(r check.Result) { printContentCheck(r.Value) }},
+		{"TLS Certificate", func() check.Result { return h.CheckCertificate(p.certLevels) }, func(r check.Result) { printCertCheck(r.Value) }},
+		{"Timing", func() check.Result { return h.CheckTimings(p.timeWarnLevels, p.timeCritLevels) }, func(r check.Result) { printTimingCheck(r.Value) }},
+		{"Response", func() check.Result { return h.CheckResponse(p.sizeLevels, p.redirectsLevels) }, func(r check.Result) { printResponseCheck(r.Value) }},
+	}
+	if len(p.certPins) > 0 || len(p.spkiPins) > 0 {
+		checks = append(checks, subCheck{"TLS Pin", func() check.Result { return h.CheckPins(p.certPins, p.spkiPins) }, func(r check.Result) { printPinCheck(r.Value) }})
+	}
+	if p.OCSP || p.OCSPQuery || p.MustStaple {
+		checks = append(checks, subCheck{"OCSP", func() check.Result { return h.CheckOCSP(p.OCSPQuery, p.MustStaple) }, func(r check.Result) { printOCSPCheck(r.Value) }})
+	}
+	if p.CRL {
+		checks = append(checks, subCheck{"CRL", func() check.Result { return h.CheckCRL(p.CRLCache) }, func(r check.Result) { printCRLCheck(r.Value) }})
+	}
+	if p.logs != nil {
+		checks = append(checks, subCheck{"Certificate Transparency", func() check.Result { return h.CheckSCT(p.logs, p.CTMin) }, func(r check.Result) { printSCTCheck(r.Value) }})
+	}
+	if p.Policy {
+		checks = append(checks, subCheck{"Certificate Policy", func() check.Result { return h.CheckPolicy(p.policy) }, func(r check.Result) { printPolicyCheck(r.Value) }})
+	}
+	if p.Issuer != "" || p.AKI != "" || p.RootSHA256 != "" || p.SAN != "" || p.Org != "" {
+		checks = append(checks, subCheck{"Certificate Expectations", h.CheckExpectations, func(r check.Result) { printExpectationsCheck(r.Value) }})
+	}
+	if p.ALPN || p.RequireH2 {
+		checks = append(checks, subCheck{"ALPN", func() check.Result { return h.CheckALPN(p.RequireH2) }, func(r check.Result) { printALPNCheck(r.Value) }})
+	}
+	if p.EachHTTP {
+		checks = append(checks, subCheck{"HTTP Versions", func() check.Result { return h.CheckEachHTTPVersion(p.StatusCodes, p.String) }, func(r check.Result) { printHTTPVersionsCheck(r.Value) }})
+	}
+	if p.HTTP3 {
+		checks = append(checks, subCheck{"HTTP/3", func() check.Result { return h.CheckHTTP3(p.StatusCodes, p.String, p.certLevels) }, func(r check.Result) { printHTTP3Check(r.Value) }})
+	}
+	if p.Protocols {
+		checks = append(checks, subCheck{"TLS Protocol", func() check.Result { return h.CheckProtocols(p.minVersion) }, func(r check.Result) { printProtocolCheck(r.Value) }})
+	}
+	if p.Ciphers {
+		checks = append(checks, subCheck{"TLS Cipher Suite", func() check.Result { return h.CheckCipherSuites(p.CiphersWarnWeak) }, func(r check.Result) { printCipherCheck(r.Value) }})
+	}
+	if p.Grade {
+		checks = append(checks, subCheck{"TLS Grade", func() check.Result { return h.CheckGrade(p.gradeWarnLevel, p.gradeCritLevel) }, func(r check.Result) { printGradeCheck(r.Value) }})
+	}
+	if p.PQ || p.PQWarnClassical {
+		checks = append(checks, subCheck{"Post-Quantum", func() check.Result { return h.CheckPostQuantum(p.PQWarnClassical) }, func(r check.Result) { printPQCheck(r.Value) }})
+	}
+	if len(h.Certificates) > 0 {
+		checks = append(checks, subCheck{"Client Certificate", h.CheckClientCertificate, func(r check.Result) { printClientCertCheck(r.Value) }})
+	}
+	return checks
+}
+
+// markUnverified notes in r that it came from a connection whose chain didn't
+// verify, unless verified is set.
+func markUnverified(r check.Result, verified bool) check.Result {
+	if verified {
+		return r
+	}
+	r.Value += " (unverified connection)"
+	r.VerboseValue = "The TLS chain didn't verify, so this response may not come from the real server.\n" + r.VerboseValue
+	return r
+}
+
+// runAll fetches host and runs every sub-check of the module whatever the
+// outcome, and returns the report of the run.
+func (p *preparedModule) runAll(host string) check.Report {
+	h := p.newCheck(host)
+	start := time.Now()
+	h.PerfData.StartTimer(start)
+
+	var runs []checkRun
+	fetchResult := h.Fetch(p.Redirects, p.UserAgent, p.Timeout)
+	if fetchResult.Error != nil || fetchResult.ReturnCode != 0 {
+		runs = append(runs, checkRun{"Connection", fetchResult, start, time.Now()})
+	} else {
+		for _, c := range p.checks(h) {
+			began := time.Now()
+			runs = append(runs, checkRun{c.name, c.run(), began, time.Now()})
+		}
+	}
+
+	report := h.Report()
+	for _, run := range runs {
+		report.AddCheck(run.name, run.result)
+	}
+	p.export(h, start, runs)
+	return report
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jeffalyanak/check_https_go/check"
+)
+
+// otlpScope names this program as the instrumentation scope of what it exports
+const otlpScope = "github.com/jeffalyanak/check_https_go"
+
+// OTLP span kinds and status codes
+const (
+	spanKindInternal = 1
+	spanKindClient   = 3
+	statusCodeError  = 2
+)
+
+// checkRun is one sub-check that was run, with when it started and ended
+type checkRun struct {
+	name   string
+	result check.Result
+	start  time.Time
+	end    time.Time
+}
+
+// otlpKeyValue is an OTLP attribute. Value holds one of stringValue,
+// intValue or doubleValue.
+type otlpKeyValue struct {
+	Key   string         `json:"key"`
+	Value map[string]any `json:"value"`
+}
+
+// otlpSpan is a span in the OTLP JSON encoding, where IDs are hex and times
+// are decimal strings of nanoseconds.
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+// otlpStatus is the status of a span, unset unless Code is statusCodeError
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// otlpMetric is a gauge metric in the OTLP JSON encoding
+type otlpMetric struct {
+	Name  string `json:"name"`
+	Unit  string `json:"unit,omitempty"`
+	Gauge struct {
+		DataPoints []otlpDataPoint `json:"dataPoints"`
+	} `json:"gauge"`
+}
+
+// otlpDataPoint is one value of a gauge
+type otlpDataPoint struct {
+	AsDouble     float64        `json:"asDouble"`
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+// newTraceID returns a random trace ID for a run of the checks
+func newTraceID() [16]byte {
+	var id [16]byte
+	rand.Read(id[:])
+	return id
+}
+
+// newSpanID returns a random span ID
+func newSpanID() [8]byte {
+	var id [8]byte
+	rand.Read(id[:])
+	return id
+}
+
+// exportOTLP sends a trace of the run of the checks against h, with spans for
+// each request Fetch made and its DNS, connect and TLS phases and for each
+// sub-check, and the perfdata as gauges, to the OTLP/HTTP collector at
+// endpoint, like http://localhost:4318.
+func exportOTLP(endpoint string, h *check.HTTPCheck, start time.Time, runs []checkRun) error {
+	end := time.Now()
+	traceID := hex.EncodeToString(h.TraceID[:])
+	url := attr("url.full", "https://"+h.URL)
+
+	worst := 0
+	var failure string
+	for _, run := range runs {
+		if w := check.WorstReturnCode(worst, runExitCode(run.result)); w != worst {
+			worst = w
+			failure = run.name + ": " + runMessage(run.result)
+		}
+	}
+
+	rootID := newSpanID()
+	root := newSpan(traceID, rootID, [8]byte{}, "HTTPS check", spanKindInternal, start, end, url, intAttr("check.exit_code", worst))
+	if worst != 0 {
+		root.Status = otlpStatus{Code: statusCodeError, Message: failure}
+	}
+	spans := []otlpSpan{root}
+
+	for i, req := range h.RequestTimings() {
+		done := req.Done
+		if done.IsZero() {
+			done = end
+		}
+		hopID := req.SpanID
+		if hopID == [8]byte{} {
+			hopID = newSpanID()
+		}
+
+		hop := newSpan(traceID, hopID, rootID, "GET", spanKindClient, req.Start, done, attr("url.full", req.URL), attr("http.request.method", "GET"), intAttr("check.redirect_hop", i))
+		if req.Status != 0 {
+			hop.Attributes = append(hop.Attributes, intAttr("http.response.status_code", req.Status))
+		} else {
+			hop.Status = otlpStatus{Code: statusCodeError, Message: "no response"}
+		}
+		spans = append(spans, hop)
+
+		for _, phase := range []struct {
+			name       string
+			start, end time.Time
+		}{
+			{"dns", req.DNSStart, req.DNSDone},
+			{"connect", req.ConnectStart, req.ConnectDone},
+			{"tls", req.TLSStart, req.TLSDone},
+		} {
+			if !phase.start.IsZero() && !phase.end.IsZero() {
+				spans = append(spans, newSpan(traceID, newSpanID(), hopID, phase.name, spanKindInternal, phase.start, phase.end))
+			}
+		}
+	}
+
+	for _, run := range runs {
+		code := runExitCode(run.result)
+		span := newSpan(traceID, newSpanID(), rootID, run.name, spanKindInternal, run.start, run.end, intAttr("check.exit_code", code))
+		if code != 0 {
+			span.Status = otlpStatus{Code: statusCodeError, Message: runMessage(run.result)}
+		}
+		spans = append(spans, span)
+	}
+
+	now := strconv.FormatInt(end.UnixNano(), 10)
+	var metrics []otlpMetric
+	for _, m := range h.PerfData.Metrics() {
+		if m.Unknown {
+			continue
+		}
+		metric := otlpMetric{Name: "check_https." + m.Label, Unit: m.UOM}
+		metric.Gauge.DataPoints = []otlpDataPoint{{AsDouble: m.Value, TimeUnixNano: now, Attributes: []otlpKeyValue{url}}}
+		metrics = append(metrics, metric)
+	}
+	exitCodes := otlpMetric{Name: "check_https.exit_code"}
+	for _, run := range runs {
+		exitCodes.Gauge.DataPoints = append(exitCodes.Gauge.DataPoints, otlpDataPoint{AsDouble: float64(runExitCode(run.result)), TimeUnixNano: now, Attributes: []otlpKeyValue{url, attr("check.name", run.name)}})
+	}
+	if len(exitCodes.Gauge.DataPoints) > 0 {
+		metrics = append(metrics, exitCodes)
+	}
+
+	resource := map[string]any{"attributes": []otlpKeyValue{attr("service.name", "check_https_go")}}
+	scope := map[string]any{"name": otlpScope}
+
+	err := postOTLP(endpoint, "/v1/traces", map[string]any{
+		"resourceSpans": []any{map[string]any{
+			"resource":   resource,
+			"scopeSpans": []any{map[string]any{"scope": scope, "spans": spans}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	return postOTLP(endpoint, "/v1/metrics", map[string]any{
+		"resourceMetrics": []any{map[string]any{
+			"resource":     resource,
+			"scopeMetrics": []any{map[string]any{"scope": scope, "metrics": metrics}},
+		}},
+	})
+}
+
+// postOTLP sends an export request in the JSON encoding to path under endpoint.
+func postOTLP(endpoint string, path string, request any) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post(strings.TrimSuffix(endpoint, "/")+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.New("OTLP export: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.New("OTLP export: " + path + ": " + resp.Status)
+	}
+	return nil
+}
+
+// newSpan returns a span with the given IDs, times and attributes
+func newSpan(traceID string, spanID [8]byte, parentID [8]byte, name string, kind int, start time.Time, end time.Time, attributes ...otlpKeyValue) otlpSpan {
+	span := otlpSpan{
+		TraceID:           traceID,
+		SpanID:            hex.EncodeToString(spanID[:]),
+		Name:              name,
+		Kind:              kind,
+		StartTimeUnixNano: strconv.FormatInt(start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        attributes,
+	}
+	if parentID != [8]byte{} {
+		span.ParentSpanID = hex.EncodeToString(parentID[:])
+	}
+	return span
+}
+
+// runExitCode returns the exit code of a result, unknown if it has an error
+func runExitCode(r check.Result) int {
+	if r.Error != nil {
+		return 3
+	}
+	return r.ReturnCode
+}
+
+// runMessage returns the error of a result, or its value if it has none
+func runMessage(r check.Result) string {
+	if r.Error != nil {
+		return r.Error.Error()
+	}
+	return r.Value
+}
+
+// attr returns a string attribute
+func attr(key string, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: map[string]any{"stringValue": value}}
+}
+
+// intAttr returns an integer attribute, which the JSON encoding writes as a
+// decimal string
+func intAttr(key string, value int) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: map[string]any{"intValue": strconv.Itoa(value)}}
+}
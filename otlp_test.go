@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jeffalyanak/check_https_go/check"
+)
+
+func TestExportOTLP(t *testing.T) {
+	var traceparent string
+	site := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Write([]byte("hello"))
+	}))
+	defer site.Close()
+
+	// The collector decodes what is posted to each path
+	var mu sync.Mutex
+	var traces struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Scope struct {
+					Name string `json:"name"`
+				} `json:"scope"`
+				Spans []otlpSpan `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	var metrics struct {
+		ResourceMetrics []struct {
+			ScopeMetrics []struct {
+				Metrics []otlpMetric `json:"metrics"`
+			} `json:"scopeMetrics"`
+		} `json:"resourceMetrics"`
+	}
+	var paths []string
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		paths = append(paths, r.URL.Path)
+
+		if r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, "want JSON", http.StatusUnsupportedMediaType)
+			return
+		}
+		var target any
+		switch r.URL.Path {
+		case "/v1/traces":
+			target = &traces
+		case "/v1/metrics":
+			target = &metrics
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer collector.Close()
+
+	start := time.Now()
+	h := &check.HTTPCheck{URL: strings.TrimPrefix(site.URL, "https://"), TraceID: newTraceID()}
+	h.PerfData.StartTimer(start)
+	if r := h.Fetch(0, "check_https_go test", 5); r.Error != nil {
+		t.Fatalf("Fetch: %v", r.Error)
+	}
+	runs := []checkRun{
+		{"Status Code", h.CheckStatus("200"), start, time.Now()},
+		{"Web Content", h.CheckContent("missing"), start, time.Now()},
+	}
+
+	if err := exportOTLP(collector.URL+"/", h, start, runs); err != nil {
+		t.Fatalf("exportOTLP: %v", err)
+	}
+	if strings.Join(paths, " ") != "/v1/traces /v1/metrics" {
+		t.Fatalf("collector received %v, want /v1/traces then /v1/metrics", paths)
+	}
+
+	if len(traces.ResourceSpans) != 1 || len(traces.ResourceSpans[0].ScopeSpans) != 1 {
+		t.Fatalf("traces = %+v, want one resource and scope", traces)
+	}
+	scope := traces.ResourceSpans[0].ScopeSpans[0]
+	if scope.Scope.Name != otlpScope {
+		t.Errorf("scope name = %q, want %q", scope.Scope.Name, otlpScope)
+	}
+
+	traceID := hex.EncodeToString(h.TraceID[:])
+	spans := map[string]otlpSpan{}
+	for _, s := range scope.Spans {
+		if s.TraceID != traceID {
+			t.Errorf("span %s in trace %s, want %s", s.Name, s.TraceID, traceID)
+		}
+		spans[s.Name] = s
+	}
+
+	root := spans["HTTPS check"]
+	if root.ParentSpanID != "" || root.Status.Code != statusCodeError || root.Status.Message != "Web Content: Unknown content returned" {
+		t.Errorf("root span = %+v, want an error status from the content check", root)
+	}
+
+	get, ok := spans["GET"]
+	if !ok || get.ParentSpanID != root.SpanID || get.Kind != spanKindClient {
+		t.Errorf("GET span = %+v, want a client span under the root", get)
+	}
+	if want := "00-" + traceID + "-" + get.SpanID + "-01"; traceparent != want {
+		t.Errorf("site received traceparent %q, want %q naming the GET span", traceparent, want)
+	}
+	for _, name := range []string{"connect", "tls"} {
+		if spans[name].ParentSpanID != get.SpanID {
+			t.Errorf("%s span = %+v, want it under the GET span", name, spans[name])
+		}
+	}
+	if s := spans["Status Code"]; s.ParentSpanID != root.SpanID || s.Status.Code != 0 {
+		t.Errorf("Status Code span = %+v, want an unset status under the root", s)
+	}
+	if s := spans["Web Content"]; s.Status.Code != statusCodeError {
+		t.Errorf("Web Content span = %+v, want an error status", s)
+	}
+
+	if len(metrics.ResourceMetrics) != 1 || len(metrics.ResourceMetrics[0].ScopeMetrics) != 1 {
+		t.Fatalf("metrics = %+v, want one resource and scope", metrics)
+	}
+	gauges := map[string]otlpMetric{}
+	for _, m := range metrics.ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		gauges[m.Name] = m
+	}
+	exitCodes := gauges["check_https.exit_code"].Gauge.DataPoints
+	if len(exitCodes) != 2 || exitCodes[0].AsDouble != 0 || exitCodes[1].AsDouble != 3 {
+		t.Errorf("check_https.exit_code = %+v, want 0 and 3", exitCodes)
+	}
+	for _, p := range exitCodes {
+		if len(p.Attributes) != 2 || p.Attributes[0].Key != "url.full" || p.Attributes[1].Key != "check.name" {
+			t.Errorf("exit code attributes = %+v, want url.full and check.name", p.Attributes)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jeffalyanak/check_https_go/check"
+)
+
+// serveProbes prepares each module and serves /probe?target=host&module=name
+// on addr in the style of the Prometheus blackbox_exporter, running the
+// module's checks against the target for every request. It only returns on
+// error.
+func serveProbes(addr string, modules map[string]module) error {
+	prepared := map[string]*preparedModule{}
+	for name, m := range modules {
+		p, err := m.prepare()
+		if err != nil {
+			return errors.New("module " + strconv.Quote(name) + ": " + err.Error())
+		}
+		prepared[name] = p
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/probe", func(w http.ResponseWriter, r *http.Request) {
+		target := strings.TrimPrefix(r.URL.Query().Get("target"), "https://")
+		if target == "" {
+			http.Error(w, "target parameter is missing", http.StatusBadRequest)
+			return
+		}
+
+		name := r.URL.Query().Get("module")
+		if name == "" {
+			name = "default"
+		}
+		p, ok := prepared[name]
+		if !ok {
+			http.Error(w, "unknown module "+strconv.Quote(name), http.StatusBadRequest)
+			return
+		}
+
+		report := p.runAll(target)
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		writeProbeMetrics(w, report)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		names := make([]string, 0, len(prepared))
+		for name := range prepared {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintln(w, "check_https_go probe server, request /probe?target=host&module=name")
+		fmt.Fprintln(w, "Modules: "+strings.Join(names, ", "))
+	})
+
+	server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	return server.ListenAndServe()
+}
+
+// writeProbeMetrics writes the report in the Prometheus text exposition
+// format. Every perfdata metric is written as probe_https_perfdata, alongside
+// the gauges named after the blackbox_exporter's.
+func writeProbeMetrics(w io.Writer, report check.Report) {
+	success := 0.0
+	if report.ExitCode == 0 {
+		success = 1
+	}
+	writeGauge(w, "probe_success", "Whether every check returned OK.", []sample{{value: success}})
+	writeGauge(w, "probe_duration_seconds", "How long the checks took in seconds.", []sample{{value: float64(report.TookMillis) / 1000}})
+	writeGauge(w, "probe_https_exit_code", "Worst Icinga/Nagios exit code of the checks, 0 to 3.", []sample{{value: float64(report.ExitCode)}})
+
+	var checks []sample
+	for _, c := range report.Checks {
+		checks = append(checks, sample{labels: [][2]string{{"check", c.Name}}, value: float64(c.ExitCode)})
+	}
+	writeGauge(w, "probe_https_check_exit_code", "Icinga/Nagios exit code of each check, 0 to 3.", checks)
+
+	if report.StatusCode != 0 {
+		writeGauge(w, "probe_http_status_code", "HTTP status code of the final response.", []sample{{value: float64(report.StatusCode)}})
+		writeGauge(w, "probe_http_redirects", "Number of redirects followed.", []sample{{value: float64(len(report.Redirects))}})
+	}
+
+	if len(report.Certificates) > 0 {
+		earliest := report.Certificates[0].NotAfter
+		for _, c := range report.Certificates {
+			if c.NotAfter.Before(earliest) {
+				earliest = c.NotAfter
+			}
+		}
+		writeGauge(w, "probe_ssl_earliest_cert_expiry", "Earliest expiry of the served and verified certificates as a Unix time.", []sample{{value: float64(earliest.Unix())}})
+	}
+
+	metrics := map[string]check.MetricReport{}
+	var perfdata []sample
+	for _, m := range report.Metrics {
+		metrics[m.Label] = m
+		if m.Value != nil {
+			perfdata = append(perfdata, sample{labels: [][2]string{{"label", m.Label}, {"uom", m.UOM}}, value: *m.Value})
+		}
+	}
+
+	var phases []sample
+	for _, phase := range check.TimingPhases {
+		if m, ok := metrics["time_"+phase]; ok && m.Value != nil {
+			phases = append(phases, sample{labels: [][2]string{{"phase", phase}}, value: math.Round(*m.Value*1000) / 1e6})
+		}
+	}
+	writeGauge(w, "probe_http_duration_seconds", "Duration of each phase of fetching the page in seconds.", phases)
+
+	writeGauge(w, "probe_https_perfdata", "Each Icinga/Nagios perfdata metric, in its unit of measurement.", perfdata)
+}
+
+// sample is one value of a gauge with its labels
+type sample struct {
+	labels [][2]string
+	value  float64
+}
+
+// writeGauge writes the HELP and TYPE lines of a gauge followed by its
+// samples, or nothing if it has none.
+func writeGauge(w io.Writer, name string, help string, samples []sample) {
+	if len(samples) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "# HELP "+name+" "+help)
+	fmt.Fprintln(w, "# TYPE "+name+" gauge")
+	for _, s := range samples {
+		line := name
+		if len(s.labels) > 0 {
+			pairs := make([]string, len(s.labels))
+			for i, l := range s.labels {
+				pairs[i] = l[0] + `="` + escapeLabelValue(l[1]) + `"`
+			}
+			line += "{" + strings.Join(pairs, ",") + "}"
+		}
+		fmt.Fprintln(w, line+" "+formatSampleValue(s.value))
+	}
+}
+
+// escapeLabelValue escapes backslashes, double quotes and newlines as the
+// exposition format requires.
+func escapeLabelValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+// formatSampleValue writes v in plain decimal form.
+func formatSampleValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
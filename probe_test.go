@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/jeffalyanak/check_https_go/check"
+)
+
+func TestWriteProbeMetrics(t *testing.T) {
+	value := func(v float64) *float64 { return &v }
+	report := check.Report{
+		URL:        "https://example.com/",
+		StatusCode: 200,
+		State:      "WARNING",
+		ExitCode:   1,
+		Checks: []check.CheckReport{
+			{Name: "Status Code", State: "OK", ExitCode: 0},
+			{Name: `Web "Content"`, State: "WARNING", ExitCode: 1},
+		},
+		Metrics: []check.MetricReport{
+			{Label: "time_tls", Value: value(12.3456), UOM: "ms"},
+			{Label: "time_total", Value: value(250), UOM: "ms"},
+			{Label: "size", Value: value(2048), UOM: "B"},
+			{Label: "ocsp_age", UOM: "s"},
+		},
+		Redirects: []check.RedirectReport{{URL: "https://example.com", Status: 301, To: "https://example.com/"}},
+		Certificates: []check.CertificateReport{
+			{Position: 0, NotAfter: time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)},
+			{Position: 1, NotAfter: time.Date(2029, 6, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		TookMillis: 1500,
+	}
+
+	var buf bytes.Buffer
+	writeProbeMetrics(&buf, report)
+
+	want := `# HELP probe_success Whether every check returned OK.
+# TYPE probe_success gauge
+probe_success 0
+# HELP probe_duration_seconds How long the checks took in seconds.
+# TYPE probe_duration_seconds gauge
+probe_duration_seconds 1.5
+# HELP probe_https_exit_code Worst Icinga/Nagios exit code of the checks, 0 to 3.
+# TYPE probe_https_exit_code gauge
+probe_https_exit_code 1
+# HELP probe_https_check_exit_code Icinga/Nagios exit code of each check, 0 to 3.
+# TYPE probe_https_check_exit_code gauge
+probe_https_check_exit_code{check="Status Code"} 0
+probe_https_check_exit_code{check="Web \"Content\""} 1
+# HELP probe_http_status_code HTTP status code of the final response.
+# TYPE probe_http_status_code gauge
+probe_http_status_code 200
+# HELP probe_http_redirects Number of redirects followed.
+# TYPE probe_http_redirects gauge
+probe_http_redirects 1
+# HELP probe_ssl_earliest_cert_expiry Earliest expiry of the served and verified certificates as a Unix time.
+# TYPE probe_ssl_earliest_cert_expiry gauge
+probe_ssl_earliest_cert_expiry 1874966400
+# HELP probe_http_duration_seconds Duration of each phase of fetching the page in seconds.
+# TYPE probe_http_duration_seconds gauge
+probe_http_duration_seconds{phase="tls"} 0.012346
+probe_http_duration_seconds{phase="total"} 0.25
+# HELP probe_https_perfdata Each Icinga/Nagios perfdata metric, in its unit of measurement.
+# TYPE probe_https_perfdata gauge
+probe_https_perfdata{label="time_tls",uom="ms"} 12.3456
+probe_https_perfdata{label="time_total",uom="ms"} 250
+probe_https_perfdata{label="size",uom="B"} 2048
+`
+	if got := buf.String(); got != want {
+		t.Errorf("writeProbeMetrics wrote:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,392 @@
+//go:build ignore
+
+// gen.go writes tables.go from the copies of the IANA TLS parameter
+// registries in the iana directory, and suites.go with the algorithms of each
+// cipher suite in the registry. A suite made of a key exchange or cipher that
+// isn't listed below stops the generator, so that it is described by hand
+// rather than guessed. To refresh the tables, download the CSV
+// files from https://www.iana.org/assignments/tls-parameters/ and
+// https://www.iana.org/assignments/tls-extensiontype-values/ over the copies
+// and run go generate.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// tables lists each registry with the map it is written to
+var tables = []struct {
+	file string
+	name string
+	kind string
+}{
+	{"tls-parameters-4.csv", "cipherSuites", "uint16"},
+	{"tls-parameters-8.csv", "groups", "uint16"},
+	{"tls-signaturescheme.csv", "signatureSchemes", "uint16"},
+	{"tls-extensiontype-values-1.csv", "extensions", "uint16"},
+	{"tls-parameters-6.csv", "alerts", "uint8"},
+}
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go from the IANA TLS parameter registries; DO NOT EDIT.\n\npackage tlsmap\n")
+
+	for _, t := range tables {
+		entries, err := readRegistry(filepath.Join("iana", t.file))
+		if err != nil {
+			log.Fatal(t.file + ": " + err.Error())
+		}
+
+		codes := make([]int, 0, len(entries))
+		for c := range entries {
+			codes = append(codes, c)
+		}
+		sort.Ints(codes)
+
+		digits := 4
+		if t.kind == "uint8" {
+			digits = 2
+		}
+
+		fmt.Fprintf(&buf, "\n// %s is generated from %s\n", t.name, t.file)
+		fmt.Fprintf(&buf, "var %s = map[%s]string{\n", t.name, t.kind)
+		for _, c := range codes {
+			fmt.Fprintf(&buf, "\t0x%0*X: %q,\n", digits, c, entries[c])
+		}
+		buf.WriteString("}\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("tables.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeSuites(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readRegistry returns the assigned codes in a registry CSV with their
+// descriptions, skipping ranges, unassigned and reserved entries.
+func readRegistry(path string) (map[int]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[int]string{}
+	for _, record := range records[1:] {
+		value, description := record[0], strings.TrimSpace(record[1])
+		if strings.ContainsAny(value, "-*") || description == "Unassigned" || strings.HasPrefix(description, "Reserved") {
+			continue
+		}
+
+		code, err := parseValue(value)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := entries[code]; ok {
+			return nil, fmt.Errorf("value %s listed twice", value)
+		}
+		entries[code] = description
+	}
+	return entries, nil
+}
+
+// parseValue reads a registry value, either a byte pair like "0x13,0x01",
+// a hex number like "0x0804" or a decimal number like "29".
+func parseValue(value string) (int, error) {
+	if hi, lo, ok := strings.Cut(value, ","); ok {
+		h, err := strconv.ParseUint(strings.TrimSpace(hi), 0, 8)
+		if err != nil {
+			return 0, err
+		}
+		l, err := strconv.ParseUint(strings.TrimSpace(lo), 0, 8)
+		if err != nil {
+			return 0, err
+		}
+		return int(h<<8 | l), nil
+	}
+
+	v, err := strconv.ParseUint(strings.TrimSpace(value), 0, 16)
+	return int(v), err
+}
+
+// keyExchange is what the part of a suite name before WITH stands for
+type keyExchange struct {
+	name           string
+	authentication string
+	forwardSecrecy bool
+}
+
+// keyExchanges lists the key exchanges of the registry by how the name spells
+// them, without the EXPORT marker. TLS 1.3 suites have none.
+var keyExchanges = map[string]keyExchange{
+	"":                {"", "", true},
+	"NULL":            {"NULL", "NULL", false},
+	"RSA":             {"RSA", "RSA", false},
+	"DH_DSS":          {"DH", "DSS", false},
+	"DH_RSA":          {"DH", "RSA", false},
+	"DHE_DSS":         {"DHE", "DSS", true},
+	"DHE_RSA":         {"DHE", "RSA", true},
+	"DH_anon":         {"DH", "anon", false},
+	"ECDH_ECDSA":      {"ECDH", "ECDSA", false},
+	"ECDH_RSA":        {"ECDH", "RSA", false},
+	"ECDHE_ECDSA":     {"ECDHE", "ECDSA", true},
+	"ECDHE_RSA":       {"ECDHE", "RSA", true},
+	"ECDH_anon":       {"ECDH", "anon", false},
+	"PSK":             {"PSK", "PSK", false},
+	"DHE_PSK":         {"DHE_PSK", "PSK", true},
+	"PSK_DHE":         {"DHE_PSK", "PSK", true},
+	"ECDHE_PSK":       {"ECDHE_PSK", "PSK", true},
+	"RSA_PSK":         {"RSA_PSK", "PSK", false},
+	"SRP_SHA":         {"SRP", "SRP", false},
+	"SRP_SHA_RSA":     {"SRP", "RSA", false},
+	"SRP_SHA_DSS":     {"SRP", "DSS", false},
+	"KRB5":            {"KRB5", "KRB5", false},
+	"ECCPWD":          {"ECCPWD", "ECCPWD", true},
+	"GOSTR341112_256": {"GOSTR341112_256", "GOSTR341112_256", false},
+}
+
+// bulkCipher is what the part of a suite name after WITH stands for, less
+// the hash
+type bulkCipher struct {
+	name string
+	mac  string // MAC named with the cipher rather than by the hash
+	bits int
+	aead bool
+}
+
+// bulkCiphers lists the ciphers of the registry by how the name spells them.
+// The bits are the effective strength, so export and 3DES suites count less
+// than their key size.
+var bulkCiphers = map[string]bulkCipher{
+	"NULL":                {"NULL", "", 0, false},
+	"NULL_NULL":           {"NULL", "NULL", 0, false},
+	"SHA256":              {"NULL", "", 0, false}, // TLS 1.3 integrity-only
+	"SHA384":              {"NULL", "", 0, false}, // TLS 1.3 integrity-only
+	"RC4_40":              {"RC4_40", "", 40, false},
+	"RC4_128":             {"RC4_128", "", 128, false},
+	"RC2_CBC_40":          {"RC2_CBC_40", "", 40, false},
+	"DES40_CBC":           {"DES40_CBC", "", 40, false},
+	"DES_CBC_40":          {"DES_CBC_40", "", 40, false},
+	"DES_CBC":             {"DES_CBC", "", 56, false},
+	"3DES_EDE_CBC":        {"3DES_EDE_CBC", "", 112, false},
+	"IDEA_CBC":            {"IDEA_CBC", "", 128, false},
+	"SEED_CBC":            {"SEED_CBC", "", 128, false},
+	"AES_128_CBC":         {"AES_128_CBC", "", 128, false},
+	"AES_256_CBC":         {"AES_256_CBC", "", 256, false},
+	"AES_128_GCM":         {"AES_128_GCM", "", 128, true},
+	"AES_256_GCM":         {"AES_256_GCM", "", 256, true},
+	"AES_128_CCM":         {"AES_128_CCM", "", 128, true},
+	"AES_256_CCM":         {"AES_256_CCM", "", 256, true},
+	"AES_128_CCM_8":       {"AES_128_CCM_8", "", 128, true},
+	"AES_256_CCM_8":       {"AES_256_CCM_8", "", 256, true},
+	"CAMELLIA_128_CBC":    {"CAMELLIA_128_CBC", "", 128, false},
+	"CAMELLIA_256_CBC":    {"CAMELLIA_256_CBC", "", 256, false},
+	"CAMELLIA_128_GCM":    {"CAMELLIA_128_GCM", "", 128, true},
+	"CAMELLIA_256_GCM":    {"CAMELLIA_256_GCM", "", 256, true},
+	"ARIA_128_CBC":        {"ARIA_128_CBC", "", 128, false},
+	"ARIA_256_CBC":        {"ARIA_256_CBC", "", 256, false},
+	"ARIA_128_GCM":        {"ARIA_128_GCM", "", 128, true},
+	"ARIA_256_GCM":        {"ARIA_256_GCM", "", 256, true},
+	"CHACHA20_POLY1305":   {"CHACHA20_POLY1305", "", 256, true},
+	"SM4_GCM":             {"SM4_GCM", "", 128, true},
+	"SM4_CCM":             {"SM4_CCM", "", 128, true},
+	"28147_CNT_IMIT":      {"28147_CNT", "IMIT", 256, false},
+	"KUZNYECHIK_CTR_OMAC": {"KUZNYECHIK_CTR", "OMAC", 256, false},
+	"MAGMA_CTR_OMAC":      {"MAGMA_CTR", "OMAC", 256, false},
+	"KUZNYECHIK_MGM_L":    {"KUZNYECHIK_MGM_L", "", 256, true},
+	"KUZNYECHIK_MGM_S":    {"KUZNYECHIK_MGM_S", "", 256, true},
+	"MAGMA_MGM_L":         {"MAGMA_MGM_L", "", 256, true},
+	"MAGMA_MGM_S":         {"MAGMA_MGM_S", "", 256, true},
+}
+
+// suiteHashes are the hashes that can end a suite name, longest first, with
+// the name of the HMAC they stand for in suites that aren't AEAD. In AEAD
+// suites the hash is only used by the PRF or HKDF.
+var suiteHashes = []struct {
+	suffix string
+	mac    string
+}{
+	{"_SHA256", "SHA256"},
+	{"_SHA384", "SHA384"},
+	{"_SHA", "SHA1"},
+	{"_MD5", "MD5"},
+	{"_SM3", "SM3"},
+}
+
+// recommendedCiphers are the ciphers recommended with an ephemeral key
+// exchange
+var recommendedCiphers = map[string]bool{"AES_128_GCM": true, "AES_256_GCM": true, "CHACHA20_POLY1305": true}
+
+// suite holds the fields of tlsmap.SuiteInfo
+type suite struct {
+	Name           string
+	KeyExchange    string
+	Authentication string
+	Cipher         string
+	MAC            string
+	KeyBits        int
+	TLS13          bool
+	AEAD           bool
+	ForwardSecrecy bool
+	Export         bool
+	Null           bool
+	RC4            bool
+	TripleDES      bool
+	Anonymous      bool
+	Rating         string
+}
+
+// writeSuites writes suites.go with the algorithms and rating of each cipher
+// suite in the registry, leaving out signalling suites.
+func writeSuites() error {
+	entries, err := readRegistry(filepath.Join("iana", "tls-parameters-4.csv"))
+	if err != nil {
+		return err
+	}
+
+	codes := make([]int, 0, len(entries))
+	for c := range entries {
+		codes = append(codes, c)
+	}
+	sort.Ints(codes)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go from the IANA TLS parameter registries; DO NOT EDIT.\n\npackage tlsmap\n")
+	buf.WriteString("\n// suites is generated from tls-parameters-4.csv\n")
+	buf.WriteString("var suites = map[uint16]SuiteInfo{\n")
+	for _, c := range codes {
+		if strings.HasSuffix(entries[c], "_SCSV") {
+			continue
+		}
+		s, err := describeSuite(entries[c])
+		if err != nil {
+			return fmt.Errorf("tls-parameters-4.csv: %s: %v", entries[c], err)
+		}
+		fmt.Fprintf(&buf, "\t0x%04X: {Name: %q, KeyExchange: %q, Authentication: %q, Cipher: %q, MAC: %q, KeyBits: %d", c, s.Name, s.KeyExchange, s.Authentication, s.Cipher, s.MAC, s.KeyBits)
+		for _, flag := range []struct {
+			name string
+			set  bool
+		}{
+			{"TLS13", s.TLS13},
+			{"AEAD", s.AEAD},
+			{"ForwardSecrecy", s.ForwardSecrecy},
+			{"Export", s.Export},
+			{"Null", s.Null},
+			{"RC4", s.RC4},
+			{"TripleDES", s.TripleDES},
+			{"Anonymous", s.Anonymous},
+		} {
+			if flag.set {
+				fmt.Fprintf(&buf, ", %s: true", flag.name)
+			}
+		}
+		fmt.Fprintf(&buf, ", Rating: %s},\n", s.Rating)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("suites.go", src, 0644)
+}
+
+// describeSuite splits a name like TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256 into
+// its key exchange, cipher and hash, looks each up and rates the result.
+func describeSuite(name string) (suite, error) {
+	s := suite{Name: name}
+
+	rest, ok := strings.CutPrefix(name, "TLS_")
+	if !ok {
+		return s, fmt.Errorf("no TLS_ prefix")
+	}
+
+	// TLS 1.3 suites name only the cipher and hash.
+	kxName, cipherName, ok := strings.Cut(rest, "_WITH_")
+	if !ok {
+		kxName, cipherName = "", rest
+		s.TLS13 = true
+	}
+	if k, ok := strings.CutSuffix(kxName, "_EXPORT"); ok {
+		kxName = k
+		s.Export = true
+	}
+
+	kx, ok := keyExchanges[kxName]
+	if !ok {
+		return s, fmt.Errorf("unknown key exchange %s", kxName)
+	}
+	s.KeyExchange, s.Authentication, s.ForwardSecrecy = kx.name, kx.authentication, kx.forwardSecrecy
+
+	hashMAC := ""
+	for _, h := range suiteHashes {
+		if c, ok := strings.CutSuffix(cipherName, h.suffix); ok {
+			cipherName, hashMAC = c, h.mac
+			break
+		}
+	}
+
+	cipher, ok := bulkCiphers[cipherName]
+	if !ok {
+		return s, fmt.Errorf("unknown cipher %s", cipherName)
+	}
+	s.Cipher, s.KeyBits, s.AEAD = cipher.name, cipher.bits, cipher.aead
+
+	switch {
+	case cipher.aead:
+		s.MAC = "AEAD"
+	case cipher.mac != "":
+		s.MAC = cipher.mac
+	case hashMAC != "":
+		s.MAC = hashMAC
+	default:
+		return s, fmt.Errorf("no MAC")
+	}
+
+	if s.Export && s.KeyBits > 56 {
+		return s, fmt.Errorf("export suite with a %d-bit cipher", s.KeyBits)
+	}
+
+	s.Null = s.Cipher == "NULL"
+	s.RC4 = strings.HasPrefix(s.Cipher, "RC4")
+	s.TripleDES = strings.HasPrefix(s.Cipher, "3DES")
+	s.Anonymous = s.Authentication == "anon"
+	s.Rating = rateSuite(s)
+
+	return s, nil
+}
+
+// rateSuite returns the name of the tlsmap.Rating of a suite.
+func rateSuite(s suite) string {
+	switch {
+	case s.Null, s.Export, s.RC4, s.Anonymous, s.MAC == "MD5",
+		strings.HasPrefix(s.Cipher, "DES"), strings.HasPrefix(s.Cipher, "RC2"):
+		return "Insecure"
+	case !s.ForwardSecrecy, !s.AEAD:
+		return "Weak"
+	case (s.KeyExchange == "" || s.KeyExchange == "ECDHE") && recommendedCiphers[s.Cipher]:
+		return "Recommended"
+	}
+	return "Secure"
+}
@@ -0,0 +1,105 @@
+package tlsmap
+
+import "fmt"
+
+// groups that combine a classical and a post-quantum key exchange, or are post-quantum alone
+var postQuantumGroups = map[uint16]bool{
+	0x0200: true,
+	0x0201: true,
+	0x0202: true,
+	0x11EB: true,
+	0x11EC: true,
+	0x11ED: true,
+	0x6399: true,
+	0x639A: true,
+}
+
+// reverse map of ALPN protocol ID to the protocol it names
+var alpnProtocols = map[string]string{
+	"http/0.9":           "HTTP/0.9",
+	"http/1.0":           "HTTP/1.0",
+	"http/1.1":           "HTTP/1.1",
+	"spdy/1":             "SPDY/1",
+	"spdy/2":             "SPDY/2",
+	"spdy/3":             "SPDY/3",
+	"stun.turn":          "Traversal Using Relays around NAT",
+	"stun.nat-discovery": "NAT discovery using STUN",
+	"h2":                 "HTTP/2 over TLS",
+	"h2c":                "HTTP/2 over TCP",
+	"webrtc":             "WebRTC media and data",
+	"c-webrtc":           "Confidential WebRTC media and data",
+	"ftp":                "FTP",
+	"imap":               "IMAP",
+	"pop3":               "POP3",
+	"managesieve":        "ManageSieve",
+	"coap":               "CoAP",
+	"xmpp-client":        "XMPP client to server",
+	"xmpp-server":        "XMPP server to server",
+	"acme-tls/1":         "ACME TLS-ALPN challenge",
+	"mqtt":               "OASIS MQTT",
+	"dot":                "DNS over TLS",
+	"ntske/1":            "Network Time Security key establishment",
+	"sunrpc":             "SunRPC",
+	"h3":                 "HTTP/3",
+	"smb":                "SMB2",
+	"irc":                "IRC",
+	"nntp":               "NNTP reading",
+	"nnsp":               "NNTP transit",
+	"doq":                "DNS over QUIC",
+	"sip/2":              "SIP",
+	"tds/8.0":            "TDS 8.0",
+	"dicom":              "DICOM",
+	"postgresql":         "PostgreSQL",
+	"radius/1.0":         "RADIUS/1.0",
+	"radius/1.1":         "RADIUS/1.1",
+}
+
+// Group returns a string from the map or else just formats the uint16
+func Group(g uint16) string {
+	str, ok := groups[g]
+	if !ok {
+		return fmt.Sprintf("%04x", g)
+	}
+	return str
+}
+
+// IsPostQuantumGroup reports whether the group is post-quantum, alone or as a hybrid
+func IsPostQuantumGroup(g uint16) bool {
+	return postQuantumGroups[g]
+}
+
+// SignatureScheme returns a string from the map or else just formats the uint16
+func SignatureScheme(s uint16) string {
+	str, ok := signatureSchemes[s]
+	if !ok {
+		return fmt.Sprintf("%04x", s)
+	}
+	return str
+}
+
+// Extension returns a string from the map or else just formats the uint16
+func Extension(e uint16) string {
+	str, ok := extensions[e]
+	if !ok {
+		return fmt.Sprintf("%04x", e)
+	}
+	return str
+}
+
+// ALPN returns the protocol an ALPN ID names from the map or else just the ID
+func ALPN(id string) string {
+	str, ok := alpnProtocols[id]
+	if !ok {
+		return id
+	}
+	return str
+}
+
+// Alert returns a string from the map or else just formats the uint8
+func Alert(a uint8) string {
+	str, ok := alerts[a]
+	if !ok {
+		return fmt.Sprintf("%02x", a)
+	}
+	return str
+}
@@ -0,0 +1,129 @@
+package tlsmap
+
+import "strings"
+
+// map of crypto/tls constant names to binary cipher suite, for suites Go
+// names differently from IANA
+var goCipherSuites = map[string]uint16{
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305":   0xCCA8,
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305": 0xCCA9,
+}
+
+// map of crypto/tls constant names to binary supported group, for groups Go
+// names differently from IANA
+var goGroups = map[string]uint16{
+	"CurveP256": 0x0017,
+	"CurveP384": 0x0018,
+	"CurveP521": 0x0019,
+}
+
+// map of crypto/tls constant names to binary signature scheme
+var goSignatureSchemes = map[string]uint16{
+	"PKCS1WithSHA1":          0x0201,
+	"PKCS1WithSHA256":        0x0401,
+	"PKCS1WithSHA384":        0x0501,
+	"PKCS1WithSHA512":        0x0601,
+	"PSSWithSHA256":          0x0804,
+	"PSSWithSHA384":          0x0805,
+	"PSSWithSHA512":          0x0806,
+	"ECDSAWithSHA1":          0x0203,
+	"ECDSAWithP256AndSHA256": 0x0403,
+	"ECDSAWithP384AndSHA384": 0x0503,
+	"ECDSAWithP521AndSHA512": 0x0603,
+	"Ed25519":                0x0807,
+}
+
+// Indexes from normalized name to code, built from the tables above and
+// the generated ones
+var (
+	cipherSuiteIDs     = index(cipherSuites, goCipherSuites, invert(opensslCipherSuites))
+	groupIDs           = index(groups, goGroups, opensslGroups)
+	signatureSchemeIDs = index(signatureSchemes, goSignatureSchemes, opensslSignatureSchemes)
+	extensionIDs       = index(extensions)
+	alertIDs           = index(widen(alerts))
+)
+
+// CipherSuiteID returns the code of a cipher suite named in its IANA, OpenSSL
+// or Go constant spelling, and whether the name is known
+func CipherSuiteID(name string) (uint16, bool) {
+	c, ok := cipherSuiteIDs[normalizeName(name)]
+	return c, ok
+}
+
+// GroupID returns the code of a supported group named in its IANA, OpenSSL
+// or Go constant spelling, and whether the name is known
+func GroupID(name string) (uint16, bool) {
+	g, ok := groupIDs[normalizeName(name)]
+	return g, ok
+}
+
+// SignatureSchemeID returns the code of a signature scheme named in its IANA,
+// OpenSSL or Go constant spelling, and whether the name is known
+func SignatureSchemeID(name string) (uint16, bool) {
+	s, ok := signatureSchemeIDs[normalizeName(name)]
+	return s, ok
+}
+
+// ExtensionID returns the code of an extension type named in its IANA
+// spelling, and whether the name is known
+func ExtensionID(name string) (uint16, bool) {
+	e, ok := extensionIDs[normalizeName(name)]
+	return e, ok
+}
+
+// AlertID returns the code of an alert description named in its IANA
+// spelling, and whether the name is known
+func AlertID(name string) (uint8, bool) {
+	a, ok := alertIDs[normalizeName(name)]
+	return uint8(a), ok
+}
+
+// normalizeName folds the differences between spellings of a name: case,
+// a tls. package qualifier, and spaces in place of underscores.
+func normalizeName(name string) string {
+	name = strings.TrimSpace(name)
+	if len(name) > 4 && strings.EqualFold(name[:4], "tls.") {
+		name = name[4:]
+	}
+	return strings.ToUpper(strings.Join(strings.Fields(name), "_"))
+}
+
+// index builds a normalized name to code index from code to name tables,
+// then name to code tables. Earlier tables win when names collide.
+func index(names map[uint16]string, aliases ...map[string]uint16) map[string]uint16 {
+	ids := map[string]uint16{}
+	add := func(name string, code uint16) {
+		key := normalizeName(name)
+		if _, ok := ids[key]; !ok {
+			ids[key] = code
+		}
+	}
+
+	for code, name := range names {
+		add(name, code)
+	}
+	for _, m := range aliases {
+		for name, code := range m {
+			add(name, code)
+		}
+	}
+	return ids
+}
+
+// invert turns a code to name table into a name to code table.
+func invert(names map[uint16]string) map[string]uint16 {
+	ids := make(map[string]uint16, len(names))
+	for code, name := range names {
+		ids[name] = code
+	}
+	return ids
+}
+
+// widen copies a table keyed by uint8 into one keyed by uint16.
+func widen(names map[uint8]string) map[uint16]string {
+	wide := make(map[uint16]string, len(names))
+	for code, name := range names {
+		wide[uint16(code)] = name
+	}
+	return wide
+}
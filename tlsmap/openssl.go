@@ -0,0 +1,296 @@
+package tlsmap
+
+// map of binary cipher suite to its OpenSSL name, for suites OpenSSL names
+// differently from IANA. TLS 1.3 suites keep their IANA names in OpenSSL.
+var opensslCipherSuites = map[uint16]string{
+	0x0001: "NULL-MD5",
+	0x0002: "NULL-SHA",
+	0x0003: "EXP-RC4-MD5",
+	0x0004: "RC4-MD5",
+	0x0005: "RC4-SHA",
+	0x0006: "EXP-RC2-CBC-MD5",
+	0x0007: "IDEA-CBC-SHA",
+	0x0008: "EXP-DES-CBC-SHA",
+	0x0009: "DES-CBC-SHA",
+	0x000A: "DES-CBC3-SHA",
+	0x000B: "EXP-DH-DSS-DES-CBC-SHA",
+	0x000C: "DH-DSS-DES-CBC-SHA",
+	0x000D: "DH-DSS-DES-CBC3-SHA",
+	0x000E: "EXP-DH-RSA-DES-CBC-SHA",
+	0x000F: "DH-RSA-DES-CBC-SHA",
+	0x0010: "DH-RSA-DES-CBC3-SHA",
+	0x0011: "EXP-EDH-DSS-DES-CBC-SHA",
+	0x0012: "EDH-DSS-DES-CBC-SHA",
+	0x0013: "EDH-DSS-DES-CBC3-SHA",
+	0x0014: "EXP-EDH-RSA-DES-CBC-SHA",
+	0x0015: "EDH-RSA-DES-CBC-SHA",
+	0x0016: "EDH-RSA-DES-CBC3-SHA",
+	0x0017: "EXP-ADH-RC4-MD5",
+	0x0018: "ADH-RC4-MD5",
+	0x0019: "EXP-ADH-DES-CBC-SHA",
+	0x001A: "ADH-DES-CBC-SHA",
+	0x001B: "ADH-DES-CBC3-SHA",
+	0x001E: "KRB5-DES-CBC-SHA",
+	0x001F: "KRB5-DES-CBC3-SHA",
+	0x0020: "KRB5-RC4-SHA",
+	0x0021: "KRB5-IDEA-CBC-SHA",
+	0x0022: "KRB5-DES-CBC-MD5",
+	0x0023: "KRB5-DES-CBC3-MD5",
+	0x0024: "KRB5-RC4-MD5",
+	0x0025: "KRB5-IDEA-CBC-MD5",
+	0x0026: "EXP-KRB5-DES-CBC-SHA",
+	0x0027: "EXP-KRB5-RC2-CBC-SHA",
+	0x0028: "EXP-KRB5-RC4-SHA",
+	0x0029: "EXP-KRB5-DES-CBC-MD5",
+	0x002A: "EXP-KRB5-RC2-CBC-MD5",
+	0x002B: "EXP-KRB5-RC4-MD5",
+	0x002C: "PSK-NULL-SHA",
+	0x002D: "DHE-PSK-NULL-SHA",
+	0x002E: "RSA-PSK-NULL-SHA",
+	0x002F: "AES128-SHA",
+	0x0030: "DH-DSS-AES128-SHA",
+	0x0031: "DH-RSA-AES128-SHA",
+	0x0032: "DHE-DSS-AES128-SHA",
+	0x0033: "DHE-RSA-AES128-SHA",
+	0x0034: "ADH-AES128-SHA",
+	0x0035: "AES256-SHA",
+	0x0036: "DH-DSS-AES256-SHA",
+	0x0037: "DH-RSA-AES256-SHA",
+	0x0038: "DHE-DSS-AES256-SHA",
+	0x0039: "DHE-RSA-AES256-SHA",
+	0x003A: "ADH-AES256-SHA",
+	0x003B: "NULL-SHA256",
+	0x003C: "AES128-SHA256",
+	0x003D: "AES256-SHA256",
+	0x003E: "DH-DSS-AES128-SHA256",
+	0x003F: "DH-RSA-AES128-SHA256",
+	0x0040: "DHE-DSS-AES128-SHA256",
+	0x0041: "CAMELLIA128-SHA",
+	0x0042: "DH-DSS-CAMELLIA128-SHA",
+	0x0043: "DH-RSA-CAMELLIA128-SHA",
+	0x0044: "DHE-DSS-CAMELLIA128-SHA",
+	0x0045: "DHE-RSA-CAMELLIA128-SHA",
+	0x0046: "ADH-CAMELLIA128-SHA",
+	0x0067: "DHE-RSA-AES128-SHA256",
+	0x0068: "DH-DSS-AES256-SHA256",
+	0x0069: "DH-RSA-AES256-SHA256",
+	0x006A: "DHE-DSS-AES256-SHA256",
+	0x006B: "DHE-RSA-AES256-SHA256",
+	0x006C: "ADH-AES128-SHA256",
+	0x006D: "ADH-AES256-SHA256",
+	0x0084: "CAMELLIA256-SHA",
+	0x0085: "DH-DSS-CAMELLIA256-SHA",
+	0x0086: "DH-RSA-CAMELLIA256-SHA",
+	0x0087: "DHE-DSS-CAMELLIA256-SHA",
+	0x0088: "DHE-RSA-CAMELLIA256-SHA",
+	0x0089: "ADH-CAMELLIA256-SHA",
+	0x008A: "PSK-RC4-SHA",
+	0x008B: "PSK-3DES-EDE-CBC-SHA",
+	0x008C: "PSK-AES128-CBC-SHA",
+	0x008D: "PSK-AES256-CBC-SHA",
+	0x008E: "DHE-PSK-RC4-SHA",
+	0x008F: "DHE-PSK-3DES-EDE-CBC-SHA",
+	0x0090: "DHE-PSK-AES128-CBC-SHA",
+	0x0091: "DHE-PSK-AES256-CBC-SHA",
+	0x0092: "RSA-PSK-RC4-SHA",
+	0x0093: "RSA-PSK-3DES-EDE-CBC-SHA",
+	0x0094: "RSA-PSK-AES128-CBC-SHA",
+	0x0095: "RSA-PSK-AES256-CBC-SHA",
+	0x0096: "SEED-SHA",
+	0x0097: "DH-DSS-SEED-SHA",
+	0x0098: "DH-RSA-SEED-SHA",
+	0x0099: "DHE-DSS-SEED-SHA",
+	0x009A: "DHE-RSA-SEED-SHA",
+	0x009B: "ADH-SEED-SHA",
+	0x009C: "AES128-GCM-SHA256",
+	0x009D: "AES256-GCM-SHA384",
+	0x009E: "DHE-RSA-AES128-GCM-SHA256",
+	0x009F: "DHE-RSA-AES256-GCM-SHA384",
+	0x00A0: "DH-RSA-AES128-GCM-SHA256",
+	0x00A1: "DH-RSA-AES256-GCM-SHA384",
+	0x00A2: "DHE-DSS-AES128-GCM-SHA256",
+	0x00A3: "DHE-DSS-AES256-GCM-SHA384",
+	0x00A4: "DH-DSS-AES128-GCM-SHA256",
+	0x00A5: "DH-DSS-AES256-GCM-SHA384",
+	0x00A6: "ADH-AES128-GCM-SHA256",
+	0x00A7: "ADH-AES256-GCM-SHA384",
+	0x00A8: "PSK-AES128-GCM-SHA256",
+	0x00A9: "PSK-AES256-GCM-SHA384",
+	0x00AA: "DHE-PSK-AES128-GCM-SHA256",
+	0x00AB: "DHE-PSK-AES256-GCM-SHA384",
+	0x00AC: "RSA-PSK-AES128-GCM-SHA256",
+	0x00AD: "RSA-PSK-AES256-GCM-SHA384",
+	0x00AE: "PSK-AES128-CBC-SHA256",
+	0x00AF: "PSK-AES256-CBC-SHA384",
+	0x00B0: "PSK-NULL-SHA256",
+	0x00B1: "PSK-NULL-SHA384",
+	0x00B2: "DHE-PSK-AES128-CBC-SHA256",
+	0x00B3: "DHE-PSK-AES256-CBC-SHA384",
+	0x00B4: "DHE-PSK-NULL-SHA256",
+	0x00B5: "DHE-PSK-NULL-SHA384",
+	0x00B6: "RSA-PSK-AES128-CBC-SHA256",
+	0x00B7: "RSA-PSK-AES256-CBC-SHA384",
+	0x00B8: "RSA-PSK-NULL-SHA256",
+	0x00B9: "RSA-PSK-NULL-SHA384",
+	0x00BA: "CAMELLIA128-SHA256",
+	0x00BB: "DH-DSS-CAMELLIA128-SHA256",
+	0x00BC: "DH-RSA-CAMELLIA128-SHA256",
+	0x00BD: "DHE-DSS-CAMELLIA128-SHA256",
+	0x00BE: "DHE-RSA-CAMELLIA128-SHA256",
+	0x00BF: "ADH-CAMELLIA128-SHA256",
+	0x00C0: "CAMELLIA256-SHA256",
+	0x00C1: "DH-DSS-CAMELLIA256-SHA256",
+	0x00C2: "DH-RSA-CAMELLIA256-SHA256",
+	0x00C3: "DHE-DSS-CAMELLIA256-SHA256",
+	0x00C4: "DHE-RSA-CAMELLIA256-SHA256",
+	0x00C5: "ADH-CAMELLIA256-SHA256",
+	0xC001: "ECDH-ECDSA-NULL-SHA",
+	0xC002: "ECDH-ECDSA-RC4-SHA",
+	0xC003: "ECDH-ECDSA-DES-CBC3-SHA",
+	0xC004: "ECDH-ECDSA-AES128-SHA",
+	0xC005: "ECDH-ECDSA-AES256-SHA",
+	0xC006: "ECDHE-ECDSA-NULL-SHA",
+	0xC007: "ECDHE-ECDSA-RC4-SHA",
+	0xC008: "ECDHE-ECDSA-DES-CBC3-SHA",
+	0xC009: "ECDHE-ECDSA-AES128-SHA",
+	0xC00A: "ECDHE-ECDSA-AES256-SHA",
+	0xC00B: "ECDH-RSA-NULL-SHA",
+	0xC00C: "ECDH-RSA-RC4-SHA",
+	0xC00D: "ECDH-RSA-DES-CBC3-SHA",
+	0xC00E: "ECDH-RSA-AES128-SHA",
+	0xC00F: "ECDH-RSA-AES256-SHA",
+	0xC010: "ECDHE-RSA-NULL-SHA",
+	0xC011: "ECDHE-RSA-RC4-SHA",
+	0xC012: "ECDHE-RSA-DES-CBC3-SHA",
+	0xC013: "ECDHE-RSA-AES128-SHA",
+	0xC014: "ECDHE-RSA-AES256-SHA",
+	0xC015: "AECDH-NULL-SHA",
+	0xC016: "AECDH-RC4-SHA",
+	0xC017: "AECDH-DES-CBC3-SHA",
+	0xC018: "AECDH-AES128-SHA",
+	0xC019: "AECDH-AES256-SHA",
+	0xC01A: "SRP-3DES-EDE-CBC-SHA",
+	0xC01B: "SRP-RSA-3DES-EDE-CBC-SHA",
+	0xC01C: "SRP-DSS-3DES-EDE-CBC-SHA",
+	0xC01D: "SRP-AES-128-CBC-SHA",
+	0xC01E: "SRP-RSA-AES-128-CBC-SHA",
+	0xC01F: "SRP-DSS-AES-128-CBC-SHA",
+	0xC020: "SRP-AES-256-CBC-SHA",
+	0xC021: "SRP-RSA-AES-256-CBC-SHA",
+	0xC022: "SRP-DSS-AES-256-CBC-SHA",
+	0xC023: "ECDHE-ECDSA-AES128-SHA256",
+	0xC024: "ECDHE-ECDSA-AES256-SHA384",
+	0xC025: "ECDH-ECDSA-AES128-SHA256",
+	0xC026: "ECDH-ECDSA-AES256-SHA384",
+	0xC027: "ECDHE-RSA-AES128-SHA256",
+	0xC028: "ECDHE-RSA-AES256-SHA384",
+	0xC029: "ECDH-RSA-AES128-SHA256",
+	0xC02A: "ECDH-RSA-AES256-SHA384",
+	0xC02B: "ECDHE-ECDSA-AES128-GCM-SHA256",
+	0xC02C: "ECDHE-ECDSA-AES256-GCM-SHA384",
+	0xC02D: "ECDH-ECDSA-AES128-GCM-SHA256",
+	0xC02E: "ECDH-ECDSA-AES256-GCM-SHA384",
+	0xC02F: "ECDHE-RSA-AES128-GCM-SHA256",
+	0xC030: "ECDHE-RSA-AES256-GCM-SHA384",
+	0xC031: "ECDH-RSA-AES128-GCM-SHA256",
+	0xC032: "ECDH-RSA-AES256-GCM-SHA384",
+	0xC033: "ECDHE-PSK-RC4-SHA",
+	0xC034: "ECDHE-PSK-3DES-EDE-CBC-SHA",
+	0xC035: "ECDHE-PSK-AES128-CBC-SHA",
+	0xC036: "ECDHE-PSK-AES256-CBC-SHA",
+	0xC037: "ECDHE-PSK-AES128-CBC-SHA256",
+	0xC038: "ECDHE-PSK-AES256-CBC-SHA384",
+	0xC039: "ECDHE-PSK-NULL-SHA",
+	0xC03A: "ECDHE-PSK-NULL-SHA256",
+	0xC03B: "ECDHE-PSK-NULL-SHA384",
+	0xC050: "ARIA128-GCM-SHA256",
+	0xC051: "ARIA256-GCM-SHA384",
+	0xC052: "DHE-RSA-ARIA128-GCM-SHA256",
+	0xC053: "DHE-RSA-ARIA256-GCM-SHA384",
+	0xC056: "DHE-DSS-ARIA128-GCM-SHA256",
+	0xC057: "DHE-DSS-ARIA256-GCM-SHA384",
+	0xC05C: "ECDHE-ECDSA-ARIA128-GCM-SHA256",
+	0xC05D: "ECDHE-ECDSA-ARIA256-GCM-SHA384",
+	0xC060: "ECDHE-ARIA128-GCM-SHA256",
+	0xC061: "ECDHE-ARIA256-GCM-SHA384",
+	0xC06A: "PSK-ARIA128-GCM-SHA256",
+	0xC06B: "PSK-ARIA256-GCM-SHA384",
+	0xC06C: "DHE-PSK-ARIA128-GCM-SHA256",
+	0xC06D: "DHE-PSK-ARIA256-GCM-SHA384",
+	0xC06E: "RSA-PSK-ARIA128-GCM-SHA256",
+	0xC06F: "RSA-PSK-ARIA256-GCM-SHA384",
+	0xC072: "ECDHE-ECDSA-CAMELLIA128-SHA256",
+	0xC073: "ECDHE-ECDSA-CAMELLIA256-SHA384",
+	0xC076: "ECDHE-RSA-CAMELLIA128-SHA256",
+	0xC077: "ECDHE-RSA-CAMELLIA256-SHA384",
+	0xC094: "PSK-CAMELLIA128-SHA256",
+	0xC095: "PSK-CAMELLIA256-SHA384",
+	0xC096: "DHE-PSK-CAMELLIA128-SHA256",
+	0xC097: "DHE-PSK-CAMELLIA256-SHA384",
+	0xC098: "RSA-PSK-CAMELLIA128-SHA256",
+	0xC099: "RSA-PSK-CAMELLIA256-SHA384",
+	0xC09A: "ECDHE-PSK-CAMELLIA128-SHA256",
+	0xC09B: "ECDHE-PSK-CAMELLIA256-SHA384",
+	0xC09C: "AES128-CCM",
+	0xC09D: "AES256-CCM",
+	0xC09E: "DHE-RSA-AES128-CCM",
+	0xC09F: "DHE-RSA-AES256-CCM",
+	0xC0A0: "AES128-CCM8",
+	0xC0A1: "AES256-CCM8",
+	0xC0A2: "DHE-RSA-AES128-CCM8",
+	0xC0A3: "DHE-RSA-AES256-CCM8",
+	0xC0A4: "PSK-AES128-CCM",
+	0xC0A5: "PSK-AES256-CCM",
+	0xC0A6: "DHE-PSK-AES128-CCM",
+	0xC0A7: "DHE-PSK-AES256-CCM",
+	0xC0A8: "PSK-AES128-CCM8",
+	0xC0A9: "PSK-AES256-CCM8",
+	0xC0AA: "DHE-PSK-AES128-CCM8",
+	0xC0AB: "DHE-PSK-AES256-CCM8",
+	0xC0AC: "ECDHE-ECDSA-AES128-CCM",
+	0xC0AD: "ECDHE-ECDSA-AES256-CCM",
+	0xC0AE: "ECDHE-ECDSA-AES128-CCM8",
+	0xC0AF: "ECDHE-ECDSA-AES256-CCM8",
+	0xCCA8: "ECDHE-RSA-CHACHA20-POLY1305",
+	0xCCA9: "ECDHE-ECDSA-CHACHA20-POLY1305",
+	0xCCAA: "DHE-RSA-CHACHA20-POLY1305",
+	0xCCAB: "PSK-CHACHA20-POLY1305",
+	0xCCAC: "ECDHE-PSK-CHACHA20-POLY1305",
+	0xCCAD: "DHE-PSK-CHACHA20-POLY1305",
+	0xCCAE: "RSA-PSK-CHACHA20-POLY1305",
+}
+
+// map of OpenSSL group and curve names to binary supported group, for
+// groups OpenSSL names differently from IANA
+var opensslGroups = map[string]uint16{
+	"prime192v1": 0x0013,
+	"prime256v1": 0x0017,
+	"P-192":      0x0013,
+	"P-224":      0x0015,
+	"P-256":      0x0017,
+	"P-384":      0x0018,
+	"P-521":      0x0019,
+}
+
+// map of OpenSSL signature algorithm names to binary signature scheme, for
+// schemes OpenSSL names differently from IANA
+var opensslSignatureSchemes = map[string]uint16{
+	"RSA+SHA1":     0x0201,
+	"RSA+SHA256":   0x0401,
+	"RSA+SHA384":   0x0501,
+	"RSA+SHA512":   0x0601,
+	"ECDSA+SHA1":   0x0203,
+	"ECDSA+SHA256": 0x0403,
+	"ECDSA+SHA384": 0x0503,
+	"ECDSA+SHA512": 0x0603,
+}
+
+// OpenSSLCipherSuite returns the OpenSSL name of a cipher suite, which is the
+// IANA name for suites OpenSSL names the same way, or else just formats the uint16
+func OpenSSLCipherSuite(c uint16) string {
+	str, ok := opensslCipherSuites[c]
+	if !ok {
+		return CipherSuite(c)
+	}
+	return str
+}
@@ -0,0 +1,52 @@
+package tlsmap
+
+// Rating is how safe a cipher suite is to enable
+type Rating int
+
+// Ratings from worst to best
+const (
+	Insecure    Rating = iota // Broken, must not be enabled
+	Weak                      // No forward secrecy, CBC mode or a 64-bit block cipher
+	Secure                    // Forward secrecy and an AEAD cipher
+	Recommended               // Secure and among the suites recommended for TLS 1.2 and 1.3
+)
+
+// reverse map of Rating to string
+var ratings = map[Rating]string{
+	Insecure:    "insecure",
+	Weak:        "weak",
+	Secure:      "secure",
+	Recommended: "recommended",
+}
+
+// String returns the name of the rating
+func (r Rating) String() string {
+	return ratings[r]
+}
+
+// SuiteInfo describes the algorithms that make up a cipher suite, as listed by
+// gen.go in suites.go
+type SuiteInfo struct {
+	Name           string // Name from the cipher suite table
+	KeyExchange    string // Key exchange, empty for TLS 1.3 suites which leave it to the handshake
+	Authentication string // Server authentication, empty for TLS 1.3 suites
+	Cipher         string // Bulk cipher and mode, like AES_128_GCM
+	MAC            string // HMAC hash like SHA1 or SHA256, AEAD for AEAD ciphers
+	KeyBits        int    // Effective strength of the bulk cipher in bits
+	TLS13          bool   // TLS 1.3 suite
+	AEAD           bool   // Cipher is an AEAD
+	ForwardSecrecy bool   // Key exchange is ephemeral
+	Export         bool   // Export grade, deliberately weakened
+	Null           bool   // No encryption
+	RC4            bool   // RC4 stream cipher
+	TripleDES      bool   // 3DES, a 64-bit block cipher
+	Anonymous      bool   // No server authentication
+	Rating         Rating // How safe the suite is to enable
+}
+
+// Info returns the algorithms that make up a cipher suite and whether the
+// suite is known. Signalling suites like TLS_FALLBACK_SCSV aren't.
+func Info(c uint16) (SuiteInfo, bool) {
+	info, ok := suites[c]
+	return info, ok
+}
@@ -0,0 +1,29 @@
+package tlsmap
+
+import "testing"
+
+func TestInfo(t *testing.T) {
+	tests := []struct {
+		code uint16
+		want SuiteInfo
+	}{
+		{0x1301, SuiteInfo{Name: "TLS_AES_128_GCM_SHA256", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, TLS13: true, AEAD: true, ForwardSecrecy: true, Rating: Recommended}},
+		{0xC0B4, SuiteInfo{Name: "TLS_SHA256_SHA256", Cipher: "NULL", MAC: "SHA256", TLS13: true, ForwardSecrecy: true, Null: true, Rating: Insecure}},
+		{0x002F, SuiteInfo{Name: "TLS_RSA_WITH_AES_128_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak}},
+		{0xC013, SuiteInfo{Name: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak}},
+		{0x0014, SuiteInfo{Name: "TLS_DHE_RSA_EXPORT_WITH_DES40_CBC_SHA", KeyExchange: "DHE", Authentication: "RSA", Cipher: "DES40_CBC", MAC: "SHA1", KeyBits: 40, ForwardSecrecy: true, Export: true, Rating: Insecure}},
+		{0x0003, SuiteInfo{Name: "TLS_RSA_EXPORT_WITH_RC4_40_MD5", KeyExchange: "RSA", Authentication: "RSA", Cipher: "RC4_40", MAC: "MD5", KeyBits: 40, Export: true, RC4: true, Rating: Insecure}},
+		{0xC02F, SuiteInfo{Name: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Recommended}},
+	}
+
+	for _, tt := range tests {
+		got, ok := Info(tt.code)
+		if !ok || got != tt.want {
+			t.Errorf("Info(0x%04X) = %+v, %v, want %+v", tt.code, got, ok, tt.want)
+		}
+	}
+
+	if _, ok := Info(0x5600); ok {
+		t.Error("Info(TLS_FALLBACK_SCSV) is known, want a signalling suite to be unknown")
+	}
+}
@@ -0,0 +1,355 @@
+// Code generated by gen.go from the IANA TLS parameter registries; DO NOT EDIT.
+
+package tlsmap
+
+// suites is generated from tls-parameters-4.csv
+var suites = map[uint16]SuiteInfo{
+	0x0000: {Name: "TLS_NULL_WITH_NULL_NULL", KeyExchange: "NULL", Authentication: "NULL", Cipher: "NULL", MAC: "NULL", KeyBits: 0, Null: true, Rating: Insecure},
+	0x0001: {Name: "TLS_RSA_WITH_NULL_MD5", KeyExchange: "RSA", Authentication: "RSA", Cipher: "NULL", MAC: "MD5", KeyBits: 0, Null: true, Rating: Insecure},
+	0x0002: {Name: "TLS_RSA_WITH_NULL_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, Null: true, Rating: Insecure},
+	0x0003: {Name: "TLS_RSA_EXPORT_WITH_RC4_40_MD5", KeyExchange: "RSA", Authentication: "RSA", Cipher: "RC4_40", MAC: "MD5", KeyBits: 40, Export: true, RC4: true, Rating: Insecure},
+	0x0004: {Name: "TLS_RSA_WITH_RC4_128_MD5", KeyExchange: "RSA", Authentication: "RSA", Cipher: "RC4_128", MAC: "MD5", KeyBits: 128, RC4: true, Rating: Insecure},
+	0x0005: {Name: "TLS_RSA_WITH_RC4_128_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, RC4: true, Rating: Insecure},
+	0x0006: {Name: "TLS_RSA_EXPORT_WITH_RC2_CBC_40_MD5", KeyExchange: "RSA", Authentication: "RSA", Cipher: "RC2_CBC_40", MAC: "MD5", KeyBits: 40, Export: true, Rating: Insecure},
+	0x0007: {Name: "TLS_RSA_WITH_IDEA_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "IDEA_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0008: {Name: "TLS_RSA_EXPORT_WITH_DES40_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "DES40_CBC", MAC: "SHA1", KeyBits: 40, Export: true, Rating: Insecure},
+	0x0009: {Name: "TLS_RSA_WITH_DES_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "DES_CBC", MAC: "SHA1", KeyBits: 56, Rating: Insecure},
+	0x000A: {Name: "TLS_RSA_WITH_3DES_EDE_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0x000B: {Name: "TLS_DH_DSS_EXPORT_WITH_DES40_CBC_SHA", KeyExchange: "DH", Authentication: "DSS", Cipher: "DES40_CBC", MAC: "SHA1", KeyBits: 40, Export: true, Rating: Insecure},
+	0x000C: {Name: "TLS_DH_DSS_WITH_DES_CBC_SHA", KeyExchange: "DH", Authentication: "DSS", Cipher: "DES_CBC", MAC: "SHA1", KeyBits: 56, Rating: Insecure},
+	0x000D: {Name: "TLS_DH_DSS_WITH_3DES_EDE_CBC_SHA", KeyExchange: "DH", Authentication: "DSS", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0x000E: {Name: "TLS_DH_RSA_EXPORT_WITH_DES40_CBC_SHA", KeyExchange: "DH", Authentication: "RSA", Cipher: "DES40_CBC", MAC: "SHA1", KeyBits: 40, Export: true, Rating: Insecure},
+	0x000F: {Name: "TLS_DH_RSA_WITH_DES_CBC_SHA", KeyExchange: "DH", Authentication: "RSA", Cipher: "DES_CBC", MAC: "SHA1", KeyBits: 56, Rating: Insecure},
+	0x0010: {Name: "TLS_DH_RSA_WITH_3DES_EDE_CBC_SHA", KeyExchange: "DH", Authentication: "RSA", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0x0011: {Name: "TLS_DHE_DSS_EXPORT_WITH_DES40_CBC_SHA", KeyExchange: "DHE", Authentication: "DSS", Cipher: "DES40_CBC", MAC: "SHA1", KeyBits: 40, ForwardSecrecy: true, Export: true, Rating: Insecure},
+	0x0012: {Name: "TLS_DHE_DSS_WITH_DES_CBC_SHA", KeyExchange: "DHE", Authentication: "DSS", Cipher: "DES_CBC", MAC: "SHA1", KeyBits: 56, ForwardSecrecy: true, Rating: Insecure},
+	0x0013: {Name: "TLS_DHE_DSS_WITH_3DES_EDE_CBC_SHA", KeyExchange: "DHE", Authentication: "DSS", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, ForwardSecrecy: true, TripleDES: true, Rating: Weak},
+	0x0014: {Name: "TLS_DHE_RSA_EXPORT_WITH_DES40_CBC_SHA", KeyExchange: "DHE", Authentication: "RSA", Cipher: "DES40_CBC", MAC: "SHA1", KeyBits: 40, ForwardSecrecy: true, Export: true, Rating: Insecure},
+	0x0015: {Name: "TLS_DHE_RSA_WITH_DES_CBC_SHA", KeyExchange: "DHE", Authentication: "RSA", Cipher: "DES_CBC", MAC: "SHA1", KeyBits: 56, ForwardSecrecy: true, Rating: Insecure},
+	0x0016: {Name: "TLS_DHE_RSA_WITH_3DES_EDE_CBC_SHA", KeyExchange: "DHE", Authentication: "RSA", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, ForwardSecrecy: true, TripleDES: true, Rating: Weak},
+	0x0017: {Name: "TLS_DH_anon_EXPORT_WITH_RC4_40_MD5", KeyExchange: "DH", Authentication: "anon", Cipher: "RC4_40", MAC: "MD5", KeyBits: 40, Export: true, RC4: true, Anonymous: true, Rating: Insecure},
+	0x0018: {Name: "TLS_DH_anon_WITH_RC4_128_MD5", KeyExchange: "DH", Authentication: "anon", Cipher: "RC4_128", MAC: "MD5", KeyBits: 128, RC4: true, Anonymous: true, Rating: Insecure},
+	0x0019: {Name: "TLS_DH_anon_EXPORT_WITH_DES40_CBC_SHA", KeyExchange: "DH", Authentication: "anon", Cipher: "DES40_CBC", MAC: "SHA1", KeyBits: 40, Export: true, Anonymous: true, Rating: Insecure},
+	0x001A: {Name: "TLS_DH_anon_WITH_DES_CBC_SHA", KeyExchange: "DH", Authentication: "anon", Cipher: "DES_CBC", MAC: "SHA1", KeyBits: 56, Anonymous: true, Rating: Insecure},
+	0x001B: {Name: "TLS_DH_anon_WITH_3DES_EDE_CBC_SHA", KeyExchange: "DH", Authentication: "anon", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Anonymous: true, Rating: Insecure},
+	0x001E: {Name: "TLS_KRB5_WITH_DES_CBC_SHA", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "DES_CBC", MAC: "SHA1", KeyBits: 56, Rating: Insecure},
+	0x001F: {Name: "TLS_KRB5_WITH_3DES_EDE_CBC_SHA", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0x0020: {Name: "TLS_KRB5_WITH_RC4_128_SHA", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, RC4: true, Rating: Insecure},
+	0x0021: {Name: "TLS_KRB5_WITH_IDEA_CBC_SHA", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "IDEA_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0022: {Name: "TLS_KRB5_WITH_DES_CBC_MD5", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "DES_CBC", MAC: "MD5", KeyBits: 56, Rating: Insecure},
+	0x0023: {Name: "TLS_KRB5_WITH_3DES_EDE_CBC_MD5", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "3DES_EDE_CBC", MAC: "MD5", KeyBits: 112, TripleDES: true, Rating: Insecure},
+	0x0024: {Name: "TLS_KRB5_WITH_RC4_128_MD5", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "RC4_128", MAC: "MD5", KeyBits: 128, RC4: true, Rating: Insecure},
+	0x0025: {Name: "TLS_KRB5_WITH_IDEA_CBC_MD5", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "IDEA_CBC", MAC: "MD5", KeyBits: 128, Rating: Insecure},
+	0x0026: {Name: "TLS_KRB5_EXPORT_WITH_DES_CBC_40_SHA", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "DES_CBC_40", MAC: "SHA1", KeyBits: 40, Export: true, Rating: Insecure},
+	0x0027: {Name: "TLS_KRB5_EXPORT_WITH_RC2_CBC_40_SHA", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "RC2_CBC_40", MAC: "SHA1", KeyBits: 40, Export: true, Rating: Insecure},
+	0x0028: {Name: "TLS_KRB5_EXPORT_WITH_RC4_40_SHA", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "RC4_40", MAC: "SHA1", KeyBits: 40, Export: true, RC4: true, Rating: Insecure},
+	0x0029: {Name: "TLS_KRB5_EXPORT_WITH_DES_CBC_40_MD5", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "DES_CBC_40", MAC: "MD5", KeyBits: 40, Export: true, Rating: Insecure},
+	0x002A: {Name: "TLS_KRB5_EXPORT_WITH_RC2_CBC_40_MD5", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "RC2_CBC_40", MAC: "MD5", KeyBits: 40, Export: true, Rating: Insecure},
+	0x002B: {Name: "TLS_KRB5_EXPORT_WITH_RC4_40_MD5", KeyExchange: "KRB5", Authentication: "KRB5", Cipher: "RC4_40", MAC: "MD5", KeyBits: 40, Export: true, RC4: true, Rating: Insecure},
+	0x002C: {Name: "TLS_PSK_WITH_NULL_SHA", KeyExchange: "PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, Null: true, Rating: Insecure},
+	0x002D: {Name: "TLS_DHE_PSK_WITH_NULL_SHA", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0x002E: {Name: "TLS_RSA_PSK_WITH_NULL_SHA", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, Null: true, Rating: Insecure},
+	0x002F: {Name: "TLS_RSA_WITH_AES_128_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0030: {Name: "TLS_DH_DSS_WITH_AES_128_CBC_SHA", KeyExchange: "DH", Authentication: "DSS", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0031: {Name: "TLS_DH_RSA_WITH_AES_128_CBC_SHA", KeyExchange: "DH", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0032: {Name: "TLS_DHE_DSS_WITH_AES_128_CBC_SHA", KeyExchange: "DHE", Authentication: "DSS", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x0033: {Name: "TLS_DHE_RSA_WITH_AES_128_CBC_SHA", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x0034: {Name: "TLS_DH_anon_WITH_AES_128_CBC_SHA", KeyExchange: "DH", Authentication: "anon", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Anonymous: true, Rating: Insecure},
+	0x0035: {Name: "TLS_RSA_WITH_AES_256_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0x0036: {Name: "TLS_DH_DSS_WITH_AES_256_CBC_SHA", KeyExchange: "DH", Authentication: "DSS", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0x0037: {Name: "TLS_DH_RSA_WITH_AES_256_CBC_SHA", KeyExchange: "DH", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0x0038: {Name: "TLS_DHE_DSS_WITH_AES_256_CBC_SHA", KeyExchange: "DHE", Authentication: "DSS", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x0039: {Name: "TLS_DHE_RSA_WITH_AES_256_CBC_SHA", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x003A: {Name: "TLS_DH_anon_WITH_AES_256_CBC_SHA", KeyExchange: "DH", Authentication: "anon", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Anonymous: true, Rating: Insecure},
+	0x003B: {Name: "TLS_RSA_WITH_NULL_SHA256", KeyExchange: "RSA", Authentication: "RSA", Cipher: "NULL", MAC: "SHA256", KeyBits: 0, Null: true, Rating: Insecure},
+	0x003C: {Name: "TLS_RSA_WITH_AES_128_CBC_SHA256", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0x003D: {Name: "TLS_RSA_WITH_AES_256_CBC_SHA256", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA256", KeyBits: 256, Rating: Weak},
+	0x003E: {Name: "TLS_DH_DSS_WITH_AES_128_CBC_SHA256", KeyExchange: "DH", Authentication: "DSS", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0x003F: {Name: "TLS_DH_RSA_WITH_AES_128_CBC_SHA256", KeyExchange: "DH", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0x0040: {Name: "TLS_DHE_DSS_WITH_AES_128_CBC_SHA256", KeyExchange: "DHE", Authentication: "DSS", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x0041: {Name: "TLS_RSA_WITH_CAMELLIA_128_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0042: {Name: "TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA", KeyExchange: "DH", Authentication: "DSS", Cipher: "CAMELLIA_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0043: {Name: "TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA", KeyExchange: "DH", Authentication: "RSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0044: {Name: "TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA", KeyExchange: "DHE", Authentication: "DSS", Cipher: "CAMELLIA_128_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x0045: {Name: "TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA", KeyExchange: "DHE", Authentication: "RSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x0046: {Name: "TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA", KeyExchange: "DH", Authentication: "anon", Cipher: "CAMELLIA_128_CBC", MAC: "SHA1", KeyBits: 128, Anonymous: true, Rating: Insecure},
+	0x0067: {Name: "TLS_DHE_RSA_WITH_AES_128_CBC_SHA256", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x0068: {Name: "TLS_DH_DSS_WITH_AES_256_CBC_SHA256", KeyExchange: "DH", Authentication: "DSS", Cipher: "AES_256_CBC", MAC: "SHA256", KeyBits: 256, Rating: Weak},
+	0x0069: {Name: "TLS_DH_RSA_WITH_AES_256_CBC_SHA256", KeyExchange: "DH", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA256", KeyBits: 256, Rating: Weak},
+	0x006A: {Name: "TLS_DHE_DSS_WITH_AES_256_CBC_SHA256", KeyExchange: "DHE", Authentication: "DSS", Cipher: "AES_256_CBC", MAC: "SHA256", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x006B: {Name: "TLS_DHE_RSA_WITH_AES_256_CBC_SHA256", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA256", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x006C: {Name: "TLS_DH_anon_WITH_AES_128_CBC_SHA256", KeyExchange: "DH", Authentication: "anon", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, Anonymous: true, Rating: Insecure},
+	0x006D: {Name: "TLS_DH_anon_WITH_AES_256_CBC_SHA256", KeyExchange: "DH", Authentication: "anon", Cipher: "AES_256_CBC", MAC: "SHA256", KeyBits: 256, Anonymous: true, Rating: Insecure},
+	0x0084: {Name: "TLS_RSA_WITH_CAMELLIA_256_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0x0085: {Name: "TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA", KeyExchange: "DH", Authentication: "DSS", Cipher: "CAMELLIA_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0x0086: {Name: "TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA", KeyExchange: "DH", Authentication: "RSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0x0087: {Name: "TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA", KeyExchange: "DHE", Authentication: "DSS", Cipher: "CAMELLIA_256_CBC", MAC: "SHA1", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x0088: {Name: "TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA", KeyExchange: "DHE", Authentication: "RSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA1", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x0089: {Name: "TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA", KeyExchange: "DH", Authentication: "anon", Cipher: "CAMELLIA_256_CBC", MAC: "SHA1", KeyBits: 256, Anonymous: true, Rating: Insecure},
+	0x008A: {Name: "TLS_PSK_WITH_RC4_128_SHA", KeyExchange: "PSK", Authentication: "PSK", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, RC4: true, Rating: Insecure},
+	0x008B: {Name: "TLS_PSK_WITH_3DES_EDE_CBC_SHA", KeyExchange: "PSK", Authentication: "PSK", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0x008C: {Name: "TLS_PSK_WITH_AES_128_CBC_SHA", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x008D: {Name: "TLS_PSK_WITH_AES_256_CBC_SHA", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0x008E: {Name: "TLS_DHE_PSK_WITH_RC4_128_SHA", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, RC4: true, Rating: Insecure},
+	0x008F: {Name: "TLS_DHE_PSK_WITH_3DES_EDE_CBC_SHA", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, ForwardSecrecy: true, TripleDES: true, Rating: Weak},
+	0x0090: {Name: "TLS_DHE_PSK_WITH_AES_128_CBC_SHA", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x0091: {Name: "TLS_DHE_PSK_WITH_AES_256_CBC_SHA", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x0092: {Name: "TLS_RSA_PSK_WITH_RC4_128_SHA", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, RC4: true, Rating: Insecure},
+	0x0093: {Name: "TLS_RSA_PSK_WITH_3DES_EDE_CBC_SHA", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0x0094: {Name: "TLS_RSA_PSK_WITH_AES_128_CBC_SHA", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0095: {Name: "TLS_RSA_PSK_WITH_AES_256_CBC_SHA", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0x0096: {Name: "TLS_RSA_WITH_SEED_CBC_SHA", KeyExchange: "RSA", Authentication: "RSA", Cipher: "SEED_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0097: {Name: "TLS_DH_DSS_WITH_SEED_CBC_SHA", KeyExchange: "DH", Authentication: "DSS", Cipher: "SEED_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0098: {Name: "TLS_DH_RSA_WITH_SEED_CBC_SHA", KeyExchange: "DH", Authentication: "RSA", Cipher: "SEED_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0x0099: {Name: "TLS_DHE_DSS_WITH_SEED_CBC_SHA", KeyExchange: "DHE", Authentication: "DSS", Cipher: "SEED_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x009A: {Name: "TLS_DHE_RSA_WITH_SEED_CBC_SHA", KeyExchange: "DHE", Authentication: "RSA", Cipher: "SEED_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x009B: {Name: "TLS_DH_anon_WITH_SEED_CBC_SHA", KeyExchange: "DH", Authentication: "anon", Cipher: "SEED_CBC", MAC: "SHA1", KeyBits: 128, Anonymous: true, Rating: Insecure},
+	0x009C: {Name: "TLS_RSA_WITH_AES_128_GCM_SHA256", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0x009D: {Name: "TLS_RSA_WITH_AES_256_GCM_SHA384", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0x009E: {Name: "TLS_DHE_RSA_WITH_AES_128_GCM_SHA256", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0x009F: {Name: "TLS_DHE_RSA_WITH_AES_256_GCM_SHA384", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0x00A0: {Name: "TLS_DH_RSA_WITH_AES_128_GCM_SHA256", KeyExchange: "DH", Authentication: "RSA", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0x00A1: {Name: "TLS_DH_RSA_WITH_AES_256_GCM_SHA384", KeyExchange: "DH", Authentication: "RSA", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0x00A2: {Name: "TLS_DHE_DSS_WITH_AES_128_GCM_SHA256", KeyExchange: "DHE", Authentication: "DSS", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0x00A3: {Name: "TLS_DHE_DSS_WITH_AES_256_GCM_SHA384", KeyExchange: "DHE", Authentication: "DSS", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0x00A4: {Name: "TLS_DH_DSS_WITH_AES_128_GCM_SHA256", KeyExchange: "DH", Authentication: "DSS", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0x00A5: {Name: "TLS_DH_DSS_WITH_AES_256_GCM_SHA384", KeyExchange: "DH", Authentication: "DSS", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0x00A6: {Name: "TLS_DH_anon_WITH_AES_128_GCM_SHA256", KeyExchange: "DH", Authentication: "anon", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Anonymous: true, Rating: Insecure},
+	0x00A7: {Name: "TLS_DH_anon_WITH_AES_256_GCM_SHA384", KeyExchange: "DH", Authentication: "anon", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Anonymous: true, Rating: Insecure},
+	0x00A8: {Name: "TLS_PSK_WITH_AES_128_GCM_SHA256", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0x00A9: {Name: "TLS_PSK_WITH_AES_256_GCM_SHA384", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0x00AA: {Name: "TLS_DHE_PSK_WITH_AES_128_GCM_SHA256", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0x00AB: {Name: "TLS_DHE_PSK_WITH_AES_256_GCM_SHA384", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0x00AC: {Name: "TLS_RSA_PSK_WITH_AES_128_GCM_SHA256", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0x00AD: {Name: "TLS_RSA_PSK_WITH_AES_256_GCM_SHA384", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0x00AE: {Name: "TLS_PSK_WITH_AES_128_CBC_SHA256", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0x00AF: {Name: "TLS_PSK_WITH_AES_256_CBC_SHA384", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0x00B0: {Name: "TLS_PSK_WITH_NULL_SHA256", KeyExchange: "PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA256", KeyBits: 0, Null: true, Rating: Insecure},
+	0x00B1: {Name: "TLS_PSK_WITH_NULL_SHA384", KeyExchange: "PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA384", KeyBits: 0, Null: true, Rating: Insecure},
+	0x00B2: {Name: "TLS_DHE_PSK_WITH_AES_128_CBC_SHA256", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x00B3: {Name: "TLS_DHE_PSK_WITH_AES_256_CBC_SHA384", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x00B4: {Name: "TLS_DHE_PSK_WITH_NULL_SHA256", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA256", KeyBits: 0, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0x00B5: {Name: "TLS_DHE_PSK_WITH_NULL_SHA384", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA384", KeyBits: 0, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0x00B6: {Name: "TLS_RSA_PSK_WITH_AES_128_CBC_SHA256", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0x00B7: {Name: "TLS_RSA_PSK_WITH_AES_256_CBC_SHA384", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "AES_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0x00B8: {Name: "TLS_RSA_PSK_WITH_NULL_SHA256", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA256", KeyBits: 0, Null: true, Rating: Insecure},
+	0x00B9: {Name: "TLS_RSA_PSK_WITH_NULL_SHA384", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA384", KeyBits: 0, Null: true, Rating: Insecure},
+	0x00BA: {Name: "TLS_RSA_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "RSA", Authentication: "RSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0x00BB: {Name: "TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "DH", Authentication: "DSS", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0x00BC: {Name: "TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "DH", Authentication: "RSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0x00BD: {Name: "TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "DHE", Authentication: "DSS", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x00BE: {Name: "TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "DHE", Authentication: "RSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0x00BF: {Name: "TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "DH", Authentication: "anon", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, Anonymous: true, Rating: Insecure},
+	0x00C0: {Name: "TLS_RSA_WITH_CAMELLIA_256_CBC_SHA256", KeyExchange: "RSA", Authentication: "RSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA256", KeyBits: 256, Rating: Weak},
+	0x00C1: {Name: "TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA256", KeyExchange: "DH", Authentication: "DSS", Cipher: "CAMELLIA_256_CBC", MAC: "SHA256", KeyBits: 256, Rating: Weak},
+	0x00C2: {Name: "TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA256", KeyExchange: "DH", Authentication: "RSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA256", KeyBits: 256, Rating: Weak},
+	0x00C3: {Name: "TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA256", KeyExchange: "DHE", Authentication: "DSS", Cipher: "CAMELLIA_256_CBC", MAC: "SHA256", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x00C4: {Name: "TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA256", KeyExchange: "DHE", Authentication: "RSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA256", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0x00C5: {Name: "TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA256", KeyExchange: "DH", Authentication: "anon", Cipher: "CAMELLIA_256_CBC", MAC: "SHA256", KeyBits: 256, Anonymous: true, Rating: Insecure},
+	0x00C6: {Name: "TLS_SM4_GCM_SM3", KeyExchange: "", Authentication: "", Cipher: "SM4_GCM", MAC: "AEAD", KeyBits: 128, TLS13: true, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0x00C7: {Name: "TLS_SM4_CCM_SM3", KeyExchange: "", Authentication: "", Cipher: "SM4_CCM", MAC: "AEAD", KeyBits: 128, TLS13: true, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0x1301: {Name: "TLS_AES_128_GCM_SHA256", KeyExchange: "", Authentication: "", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, TLS13: true, AEAD: true, ForwardSecrecy: true, Rating: Recommended},
+	0x1302: {Name: "TLS_AES_256_GCM_SHA384", KeyExchange: "", Authentication: "", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, TLS13: true, AEAD: true, ForwardSecrecy: true, Rating: Recommended},
+	0x1303: {Name: "TLS_CHACHA20_POLY1305_SHA256", KeyExchange: "", Authentication: "", Cipher: "CHACHA20_POLY1305", MAC: "AEAD", KeyBits: 256, TLS13: true, AEAD: true, ForwardSecrecy: true, Rating: Recommended},
+	0x1304: {Name: "TLS_AES_128_CCM_SHA256", KeyExchange: "", Authentication: "", Cipher: "AES_128_CCM", MAC: "AEAD", KeyBits: 128, TLS13: true, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0x1305: {Name: "TLS_AES_128_CCM_8_SHA256", KeyExchange: "", Authentication: "", Cipher: "AES_128_CCM_8", MAC: "AEAD", KeyBits: 128, TLS13: true, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC001: {Name: "TLS_ECDH_ECDSA_WITH_NULL_SHA", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, Null: true, Rating: Insecure},
+	0xC002: {Name: "TLS_ECDH_ECDSA_WITH_RC4_128_SHA", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, RC4: true, Rating: Insecure},
+	0xC003: {Name: "TLS_ECDH_ECDSA_WITH_3DES_EDE_CBC_SHA", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0xC004: {Name: "TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0xC005: {Name: "TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0xC006: {Name: "TLS_ECDHE_ECDSA_WITH_NULL_SHA", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0xC007: {Name: "TLS_ECDHE_ECDSA_WITH_RC4_128_SHA", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, RC4: true, Rating: Insecure},
+	0xC008: {Name: "TLS_ECDHE_ECDSA_WITH_3DES_EDE_CBC_SHA", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, ForwardSecrecy: true, TripleDES: true, Rating: Weak},
+	0xC009: {Name: "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC00A: {Name: "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC00B: {Name: "TLS_ECDH_RSA_WITH_NULL_SHA", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, Null: true, Rating: Insecure},
+	0xC00C: {Name: "TLS_ECDH_RSA_WITH_RC4_128_SHA", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, RC4: true, Rating: Insecure},
+	0xC00D: {Name: "TLS_ECDH_RSA_WITH_3DES_EDE_CBC_SHA", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0xC00E: {Name: "TLS_ECDH_RSA_WITH_AES_128_CBC_SHA", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0xC00F: {Name: "TLS_ECDH_RSA_WITH_AES_256_CBC_SHA", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0xC010: {Name: "TLS_ECDHE_RSA_WITH_NULL_SHA", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0xC011: {Name: "TLS_ECDHE_RSA_WITH_RC4_128_SHA", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, RC4: true, Rating: Insecure},
+	0xC012: {Name: "TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, ForwardSecrecy: true, TripleDES: true, Rating: Weak},
+	0xC013: {Name: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC014: {Name: "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC015: {Name: "TLS_ECDH_anon_WITH_NULL_SHA", KeyExchange: "ECDH", Authentication: "anon", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, Null: true, Anonymous: true, Rating: Insecure},
+	0xC016: {Name: "TLS_ECDH_anon_WITH_RC4_128_SHA", KeyExchange: "ECDH", Authentication: "anon", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, RC4: true, Anonymous: true, Rating: Insecure},
+	0xC017: {Name: "TLS_ECDH_anon_WITH_3DES_EDE_CBC_SHA", KeyExchange: "ECDH", Authentication: "anon", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Anonymous: true, Rating: Insecure},
+	0xC018: {Name: "TLS_ECDH_anon_WITH_AES_128_CBC_SHA", KeyExchange: "ECDH", Authentication: "anon", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Anonymous: true, Rating: Insecure},
+	0xC019: {Name: "TLS_ECDH_anon_WITH_AES_256_CBC_SHA", KeyExchange: "ECDH", Authentication: "anon", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Anonymous: true, Rating: Insecure},
+	0xC01A: {Name: "TLS_SRP_SHA_WITH_3DES_EDE_CBC_SHA", KeyExchange: "SRP", Authentication: "SRP", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0xC01B: {Name: "TLS_SRP_SHA_RSA_WITH_3DES_EDE_CBC_SHA", KeyExchange: "SRP", Authentication: "RSA", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0xC01C: {Name: "TLS_SRP_SHA_DSS_WITH_3DES_EDE_CBC_SHA", KeyExchange: "SRP", Authentication: "DSS", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, TripleDES: true, Rating: Weak},
+	0xC01D: {Name: "TLS_SRP_SHA_WITH_AES_128_CBC_SHA", KeyExchange: "SRP", Authentication: "SRP", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0xC01E: {Name: "TLS_SRP_SHA_RSA_WITH_AES_128_CBC_SHA", KeyExchange: "SRP", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0xC01F: {Name: "TLS_SRP_SHA_DSS_WITH_AES_128_CBC_SHA", KeyExchange: "SRP", Authentication: "DSS", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, Rating: Weak},
+	0xC020: {Name: "TLS_SRP_SHA_WITH_AES_256_CBC_SHA", KeyExchange: "SRP", Authentication: "SRP", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0xC021: {Name: "TLS_SRP_SHA_RSA_WITH_AES_256_CBC_SHA", KeyExchange: "SRP", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0xC022: {Name: "TLS_SRP_SHA_DSS_WITH_AES_256_CBC_SHA", KeyExchange: "SRP", Authentication: "DSS", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, Rating: Weak},
+	0xC023: {Name: "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC024: {Name: "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC025: {Name: "TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA256", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC026: {Name: "TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA384", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "AES_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC027: {Name: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC028: {Name: "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA384", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC029: {Name: "TLS_ECDH_RSA_WITH_AES_128_CBC_SHA256", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC02A: {Name: "TLS_ECDH_RSA_WITH_AES_256_CBC_SHA384", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "AES_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC02B: {Name: "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Recommended},
+	0xC02C: {Name: "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Recommended},
+	0xC02D: {Name: "TLS_ECDH_ECDSA_WITH_AES_128_GCM_SHA256", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC02E: {Name: "TLS_ECDH_ECDSA_WITH_AES_256_GCM_SHA384", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC02F: {Name: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Recommended},
+	0xC030: {Name: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Recommended},
+	0xC031: {Name: "TLS_ECDH_RSA_WITH_AES_128_GCM_SHA256", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC032: {Name: "TLS_ECDH_RSA_WITH_AES_256_GCM_SHA384", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC033: {Name: "TLS_ECDHE_PSK_WITH_RC4_128_SHA", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "RC4_128", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, RC4: true, Rating: Insecure},
+	0xC034: {Name: "TLS_ECDHE_PSK_WITH_3DES_EDE_CBC_SHA", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "3DES_EDE_CBC", MAC: "SHA1", KeyBits: 112, ForwardSecrecy: true, TripleDES: true, Rating: Weak},
+	0xC035: {Name: "TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "AES_128_CBC", MAC: "SHA1", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC036: {Name: "TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "AES_256_CBC", MAC: "SHA1", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC037: {Name: "TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA256", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "AES_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC038: {Name: "TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA384", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "AES_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC039: {Name: "TLS_ECDHE_PSK_WITH_NULL_SHA", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA1", KeyBits: 0, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0xC03A: {Name: "TLS_ECDHE_PSK_WITH_NULL_SHA256", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA256", KeyBits: 0, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0xC03B: {Name: "TLS_ECDHE_PSK_WITH_NULL_SHA384", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "NULL", MAC: "SHA384", KeyBits: 0, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0xC03C: {Name: "TLS_RSA_WITH_ARIA_128_CBC_SHA256", KeyExchange: "RSA", Authentication: "RSA", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC03D: {Name: "TLS_RSA_WITH_ARIA_256_CBC_SHA384", KeyExchange: "RSA", Authentication: "RSA", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC03E: {Name: "TLS_DH_DSS_WITH_ARIA_128_CBC_SHA256", KeyExchange: "DH", Authentication: "DSS", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC03F: {Name: "TLS_DH_DSS_WITH_ARIA_256_CBC_SHA384", KeyExchange: "DH", Authentication: "DSS", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC040: {Name: "TLS_DH_RSA_WITH_ARIA_128_CBC_SHA256", KeyExchange: "DH", Authentication: "RSA", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC041: {Name: "TLS_DH_RSA_WITH_ARIA_256_CBC_SHA384", KeyExchange: "DH", Authentication: "RSA", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC042: {Name: "TLS_DHE_DSS_WITH_ARIA_128_CBC_SHA256", KeyExchange: "DHE", Authentication: "DSS", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC043: {Name: "TLS_DHE_DSS_WITH_ARIA_256_CBC_SHA384", KeyExchange: "DHE", Authentication: "DSS", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC044: {Name: "TLS_DHE_RSA_WITH_ARIA_128_CBC_SHA256", KeyExchange: "DHE", Authentication: "RSA", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC045: {Name: "TLS_DHE_RSA_WITH_ARIA_256_CBC_SHA384", KeyExchange: "DHE", Authentication: "RSA", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC046: {Name: "TLS_DH_anon_WITH_ARIA_128_CBC_SHA256", KeyExchange: "DH", Authentication: "anon", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, Anonymous: true, Rating: Insecure},
+	0xC047: {Name: "TLS_DH_anon_WITH_ARIA_256_CBC_SHA384", KeyExchange: "DH", Authentication: "anon", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, Anonymous: true, Rating: Insecure},
+	0xC048: {Name: "TLS_ECDHE_ECDSA_WITH_ARIA_128_CBC_SHA256", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC049: {Name: "TLS_ECDHE_ECDSA_WITH_ARIA_256_CBC_SHA384", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC04A: {Name: "TLS_ECDH_ECDSA_WITH_ARIA_128_CBC_SHA256", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC04B: {Name: "TLS_ECDH_ECDSA_WITH_ARIA_256_CBC_SHA384", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC04C: {Name: "TLS_ECDHE_RSA_WITH_ARIA_128_CBC_SHA256", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC04D: {Name: "TLS_ECDHE_RSA_WITH_ARIA_256_CBC_SHA384", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC04E: {Name: "TLS_ECDH_RSA_WITH_ARIA_128_CBC_SHA256", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC04F: {Name: "TLS_ECDH_RSA_WITH_ARIA_256_CBC_SHA384", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC050: {Name: "TLS_RSA_WITH_ARIA_128_GCM_SHA256", KeyExchange: "RSA", Authentication: "RSA", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC051: {Name: "TLS_RSA_WITH_ARIA_256_GCM_SHA384", KeyExchange: "RSA", Authentication: "RSA", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC052: {Name: "TLS_DHE_RSA_WITH_ARIA_128_GCM_SHA256", KeyExchange: "DHE", Authentication: "RSA", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC053: {Name: "TLS_DHE_RSA_WITH_ARIA_256_GCM_SHA384", KeyExchange: "DHE", Authentication: "RSA", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC054: {Name: "TLS_DH_RSA_WITH_ARIA_128_GCM_SHA256", KeyExchange: "DH", Authentication: "RSA", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC055: {Name: "TLS_DH_RSA_WITH_ARIA_256_GCM_SHA384", KeyExchange: "DH", Authentication: "RSA", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC056: {Name: "TLS_DHE_DSS_WITH_ARIA_128_GCM_SHA256", KeyExchange: "DHE", Authentication: "DSS", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC057: {Name: "TLS_DHE_DSS_WITH_ARIA_256_GCM_SHA384", KeyExchange: "DHE", Authentication: "DSS", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC058: {Name: "TLS_DH_DSS_WITH_ARIA_128_GCM_SHA256", KeyExchange: "DH", Authentication: "DSS", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC059: {Name: "TLS_DH_DSS_WITH_ARIA_256_GCM_SHA384", KeyExchange: "DH", Authentication: "DSS", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC05A: {Name: "TLS_DH_anon_WITH_ARIA_128_GCM_SHA256", KeyExchange: "DH", Authentication: "anon", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Anonymous: true, Rating: Insecure},
+	0xC05B: {Name: "TLS_DH_anon_WITH_ARIA_256_GCM_SHA384", KeyExchange: "DH", Authentication: "anon", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Anonymous: true, Rating: Insecure},
+	0xC05C: {Name: "TLS_ECDHE_ECDSA_WITH_ARIA_128_GCM_SHA256", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC05D: {Name: "TLS_ECDHE_ECDSA_WITH_ARIA_256_GCM_SHA384", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC05E: {Name: "TLS_ECDH_ECDSA_WITH_ARIA_128_GCM_SHA256", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC05F: {Name: "TLS_ECDH_ECDSA_WITH_ARIA_256_GCM_SHA384", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC060: {Name: "TLS_ECDHE_RSA_WITH_ARIA_128_GCM_SHA256", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC061: {Name: "TLS_ECDHE_RSA_WITH_ARIA_256_GCM_SHA384", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC062: {Name: "TLS_ECDH_RSA_WITH_ARIA_128_GCM_SHA256", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC063: {Name: "TLS_ECDH_RSA_WITH_ARIA_256_GCM_SHA384", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC064: {Name: "TLS_PSK_WITH_ARIA_128_CBC_SHA256", KeyExchange: "PSK", Authentication: "PSK", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC065: {Name: "TLS_PSK_WITH_ARIA_256_CBC_SHA384", KeyExchange: "PSK", Authentication: "PSK", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC066: {Name: "TLS_DHE_PSK_WITH_ARIA_128_CBC_SHA256", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC067: {Name: "TLS_DHE_PSK_WITH_ARIA_256_CBC_SHA384", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC068: {Name: "TLS_RSA_PSK_WITH_ARIA_128_CBC_SHA256", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC069: {Name: "TLS_RSA_PSK_WITH_ARIA_256_CBC_SHA384", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC06A: {Name: "TLS_PSK_WITH_ARIA_128_GCM_SHA256", KeyExchange: "PSK", Authentication: "PSK", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC06B: {Name: "TLS_PSK_WITH_ARIA_256_GCM_SHA384", KeyExchange: "PSK", Authentication: "PSK", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC06C: {Name: "TLS_DHE_PSK_WITH_ARIA_128_GCM_SHA256", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC06D: {Name: "TLS_DHE_PSK_WITH_ARIA_256_GCM_SHA384", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC06E: {Name: "TLS_RSA_PSK_WITH_ARIA_128_GCM_SHA256", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "ARIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC06F: {Name: "TLS_RSA_PSK_WITH_ARIA_256_GCM_SHA384", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "ARIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC070: {Name: "TLS_ECDHE_PSK_WITH_ARIA_128_CBC_SHA256", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "ARIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC071: {Name: "TLS_ECDHE_PSK_WITH_ARIA_256_CBC_SHA384", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "ARIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC072: {Name: "TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC073: {Name: "TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_CBC_SHA384", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC074: {Name: "TLS_ECDH_ECDSA_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC075: {Name: "TLS_ECDH_ECDSA_WITH_CAMELLIA_256_CBC_SHA384", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC076: {Name: "TLS_ECDHE_RSA_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC077: {Name: "TLS_ECDHE_RSA_WITH_CAMELLIA_256_CBC_SHA384", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC078: {Name: "TLS_ECDH_RSA_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC079: {Name: "TLS_ECDH_RSA_WITH_CAMELLIA_256_CBC_SHA384", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "CAMELLIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC07A: {Name: "TLS_RSA_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "RSA", Authentication: "RSA", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC07B: {Name: "TLS_RSA_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "RSA", Authentication: "RSA", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC07C: {Name: "TLS_DHE_RSA_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "DHE", Authentication: "RSA", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC07D: {Name: "TLS_DHE_RSA_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "DHE", Authentication: "RSA", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC07E: {Name: "TLS_DH_RSA_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "DH", Authentication: "RSA", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC07F: {Name: "TLS_DH_RSA_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "DH", Authentication: "RSA", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC080: {Name: "TLS_DHE_DSS_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "DHE", Authentication: "DSS", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC081: {Name: "TLS_DHE_DSS_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "DHE", Authentication: "DSS", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC082: {Name: "TLS_DH_DSS_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "DH", Authentication: "DSS", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC083: {Name: "TLS_DH_DSS_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "DH", Authentication: "DSS", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC084: {Name: "TLS_DH_anon_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "DH", Authentication: "anon", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Anonymous: true, Rating: Insecure},
+	0xC085: {Name: "TLS_DH_anon_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "DH", Authentication: "anon", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Anonymous: true, Rating: Insecure},
+	0xC086: {Name: "TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC087: {Name: "TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC088: {Name: "TLS_ECDH_ECDSA_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC089: {Name: "TLS_ECDH_ECDSA_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "ECDH", Authentication: "ECDSA", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC08A: {Name: "TLS_ECDHE_RSA_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC08B: {Name: "TLS_ECDHE_RSA_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC08C: {Name: "TLS_ECDH_RSA_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC08D: {Name: "TLS_ECDH_RSA_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "ECDH", Authentication: "RSA", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC08E: {Name: "TLS_PSK_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "PSK", Authentication: "PSK", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC08F: {Name: "TLS_PSK_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "PSK", Authentication: "PSK", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC090: {Name: "TLS_DHE_PSK_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC091: {Name: "TLS_DHE_PSK_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC092: {Name: "TLS_RSA_PSK_WITH_CAMELLIA_128_GCM_SHA256", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "CAMELLIA_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC093: {Name: "TLS_RSA_PSK_WITH_CAMELLIA_256_GCM_SHA384", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "CAMELLIA_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC094: {Name: "TLS_PSK_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "PSK", Authentication: "PSK", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC095: {Name: "TLS_PSK_WITH_CAMELLIA_256_CBC_SHA384", KeyExchange: "PSK", Authentication: "PSK", Cipher: "CAMELLIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC096: {Name: "TLS_DHE_PSK_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC097: {Name: "TLS_DHE_PSK_WITH_CAMELLIA_256_CBC_SHA384", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "CAMELLIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC098: {Name: "TLS_RSA_PSK_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, Rating: Weak},
+	0xC099: {Name: "TLS_RSA_PSK_WITH_CAMELLIA_256_CBC_SHA384", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "CAMELLIA_256_CBC", MAC: "SHA384", KeyBits: 256, Rating: Weak},
+	0xC09A: {Name: "TLS_ECDHE_PSK_WITH_CAMELLIA_128_CBC_SHA256", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "CAMELLIA_128_CBC", MAC: "SHA256", KeyBits: 128, ForwardSecrecy: true, Rating: Weak},
+	0xC09B: {Name: "TLS_ECDHE_PSK_WITH_CAMELLIA_256_CBC_SHA384", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "CAMELLIA_256_CBC", MAC: "SHA384", KeyBits: 256, ForwardSecrecy: true, Rating: Weak},
+	0xC09C: {Name: "TLS_RSA_WITH_AES_128_CCM", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_128_CCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC09D: {Name: "TLS_RSA_WITH_AES_256_CCM", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_256_CCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC09E: {Name: "TLS_DHE_RSA_WITH_AES_128_CCM", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_128_CCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC09F: {Name: "TLS_DHE_RSA_WITH_AES_256_CCM", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_256_CCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0A0: {Name: "TLS_RSA_WITH_AES_128_CCM_8", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_128_CCM_8", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC0A1: {Name: "TLS_RSA_WITH_AES_256_CCM_8", KeyExchange: "RSA", Authentication: "RSA", Cipher: "AES_256_CCM_8", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC0A2: {Name: "TLS_DHE_RSA_WITH_AES_128_CCM_8", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_128_CCM_8", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0A3: {Name: "TLS_DHE_RSA_WITH_AES_256_CCM_8", KeyExchange: "DHE", Authentication: "RSA", Cipher: "AES_256_CCM_8", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0A4: {Name: "TLS_PSK_WITH_AES_128_CCM", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_128_CCM", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC0A5: {Name: "TLS_PSK_WITH_AES_256_CCM", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_256_CCM", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC0A6: {Name: "TLS_DHE_PSK_WITH_AES_128_CCM", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_128_CCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0A7: {Name: "TLS_DHE_PSK_WITH_AES_256_CCM", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_256_CCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0A8: {Name: "TLS_PSK_WITH_AES_128_CCM_8", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_128_CCM_8", MAC: "AEAD", KeyBits: 128, AEAD: true, Rating: Weak},
+	0xC0A9: {Name: "TLS_PSK_WITH_AES_256_CCM_8", KeyExchange: "PSK", Authentication: "PSK", Cipher: "AES_256_CCM_8", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC0AA: {Name: "TLS_PSK_DHE_WITH_AES_128_CCM_8", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_128_CCM_8", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0AB: {Name: "TLS_PSK_DHE_WITH_AES_256_CCM_8", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "AES_256_CCM_8", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0AC: {Name: "TLS_ECDHE_ECDSA_WITH_AES_128_CCM", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_128_CCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0AD: {Name: "TLS_ECDHE_ECDSA_WITH_AES_256_CCM", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_256_CCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0AE: {Name: "TLS_ECDHE_ECDSA_WITH_AES_128_CCM_8", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_128_CCM_8", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0AF: {Name: "TLS_ECDHE_ECDSA_WITH_AES_256_CCM_8", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "AES_256_CCM_8", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0B0: {Name: "TLS_ECCPWD_WITH_AES_128_GCM_SHA256", KeyExchange: "ECCPWD", Authentication: "ECCPWD", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0B1: {Name: "TLS_ECCPWD_WITH_AES_256_GCM_SHA384", KeyExchange: "ECCPWD", Authentication: "ECCPWD", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0B2: {Name: "TLS_ECCPWD_WITH_AES_128_CCM_SHA256", KeyExchange: "ECCPWD", Authentication: "ECCPWD", Cipher: "AES_128_CCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0B3: {Name: "TLS_ECCPWD_WITH_AES_256_CCM_SHA384", KeyExchange: "ECCPWD", Authentication: "ECCPWD", Cipher: "AES_256_CCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xC0B4: {Name: "TLS_SHA256_SHA256", KeyExchange: "", Authentication: "", Cipher: "NULL", MAC: "SHA256", KeyBits: 0, TLS13: true, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0xC0B5: {Name: "TLS_SHA384_SHA384", KeyExchange: "", Authentication: "", Cipher: "NULL", MAC: "SHA384", KeyBits: 0, TLS13: true, ForwardSecrecy: true, Null: true, Rating: Insecure},
+	0xC100: {Name: "TLS_GOSTR341112_256_WITH_KUZNYECHIK_CTR_OMAC", KeyExchange: "GOSTR341112_256", Authentication: "GOSTR341112_256", Cipher: "KUZNYECHIK_CTR", MAC: "OMAC", KeyBits: 256, Rating: Weak},
+	0xC101: {Name: "TLS_GOSTR341112_256_WITH_MAGMA_CTR_OMAC", KeyExchange: "GOSTR341112_256", Authentication: "GOSTR341112_256", Cipher: "MAGMA_CTR", MAC: "OMAC", KeyBits: 256, Rating: Weak},
+	0xC102: {Name: "TLS_GOSTR341112_256_WITH_28147_CNT_IMIT", KeyExchange: "GOSTR341112_256", Authentication: "GOSTR341112_256", Cipher: "28147_CNT", MAC: "IMIT", KeyBits: 256, Rating: Weak},
+	0xC103: {Name: "TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_L", KeyExchange: "GOSTR341112_256", Authentication: "GOSTR341112_256", Cipher: "KUZNYECHIK_MGM_L", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC104: {Name: "TLS_GOSTR341112_256_WITH_MAGMA_MGM_L", KeyExchange: "GOSTR341112_256", Authentication: "GOSTR341112_256", Cipher: "MAGMA_MGM_L", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC105: {Name: "TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_S", KeyExchange: "GOSTR341112_256", Authentication: "GOSTR341112_256", Cipher: "KUZNYECHIK_MGM_S", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xC106: {Name: "TLS_GOSTR341112_256_WITH_MAGMA_MGM_S", KeyExchange: "GOSTR341112_256", Authentication: "GOSTR341112_256", Cipher: "MAGMA_MGM_S", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xCCA8: {Name: "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256", KeyExchange: "ECDHE", Authentication: "RSA", Cipher: "CHACHA20_POLY1305", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Recommended},
+	0xCCA9: {Name: "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256", KeyExchange: "ECDHE", Authentication: "ECDSA", Cipher: "CHACHA20_POLY1305", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Recommended},
+	0xCCAA: {Name: "TLS_DHE_RSA_WITH_CHACHA20_POLY1305_SHA256", KeyExchange: "DHE", Authentication: "RSA", Cipher: "CHACHA20_POLY1305", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xCCAB: {Name: "TLS_PSK_WITH_CHACHA20_POLY1305_SHA256", KeyExchange: "PSK", Authentication: "PSK", Cipher: "CHACHA20_POLY1305", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xCCAC: {Name: "TLS_ECDHE_PSK_WITH_CHACHA20_POLY1305_SHA256", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "CHACHA20_POLY1305", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xCCAD: {Name: "TLS_DHE_PSK_WITH_CHACHA20_POLY1305_SHA256", KeyExchange: "DHE_PSK", Authentication: "PSK", Cipher: "CHACHA20_POLY1305", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xCCAE: {Name: "TLS_RSA_PSK_WITH_CHACHA20_POLY1305_SHA256", KeyExchange: "RSA_PSK", Authentication: "PSK", Cipher: "CHACHA20_POLY1305", MAC: "AEAD", KeyBits: 256, AEAD: true, Rating: Weak},
+	0xD001: {Name: "TLS_ECDHE_PSK_WITH_AES_128_GCM_SHA256", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "AES_128_GCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xD002: {Name: "TLS_ECDHE_PSK_WITH_AES_256_GCM_SHA384", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "AES_256_GCM", MAC: "AEAD", KeyBits: 256, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xD003: {Name: "TLS_ECDHE_PSK_WITH_AES_128_CCM_8_SHA256", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "AES_128_CCM_8", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+	0xD005: {Name: "TLS_ECDHE_PSK_WITH_AES_128_CCM_SHA256", KeyExchange: "ECDHE_PSK", Authentication: "PSK", Cipher: "AES_128_CCM", MAC: "AEAD", KeyBits: 128, AEAD: true, ForwardSecrecy: true, Rating: Secure},
+}
@@ -0,0 +1,566 @@
+// Code generated by gen.go from the IANA TLS parameter registries; DO NOT EDIT.
+
+package tlsmap
+
+// cipherSuites is generated from tls-parameters-4.csv
+var cipherSuites = map[uint16]string{
+	0x0000: "TLS_NULL_WITH_NULL_NULL",
+	0x0001: "TLS_RSA_WITH_NULL_MD5",
+	0x0002: "TLS_RSA_WITH_NULL_SHA",
+	0x0003: "TLS_RSA_EXPORT_WITH_RC4_40_MD5",
+	0x0004: "TLS_RSA_WITH_RC4_128_MD5",
+	0x0005: "TLS_RSA_WITH_RC4_128_SHA",
+	0x0006: "TLS_RSA_EXPORT_WITH_RC2_CBC_40_MD5",
+	0x0007: "TLS_RSA_WITH_IDEA_CBC_SHA",
+	0x0008: "TLS_RSA_EXPORT_WITH_DES40_CBC_SHA",
+	0x0009: "TLS_RSA_WITH_DES_CBC_SHA",
+	0x000A: "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+	0x000B: "TLS_DH_DSS_EXPORT_WITH_DES40_CBC_SHA",
+	0x000C: "TLS_DH_DSS_WITH_DES_CBC_SHA",
+	0x000D: "TLS_DH_DSS_WITH_3DES_EDE_CBC_SHA",
+	0x000E: "TLS_DH_RSA_EXPORT_WITH_DES40_CBC_SHA",
+	0x000F: "TLS_DH_RSA_WITH_DES_CBC_SHA",
+	0x0010: "TLS_DH_RSA_WITH_3DES_EDE_CBC_SHA",
+	0x0011: "TLS_DHE_DSS_EXPORT_WITH_DES40_CBC_SHA",
+	0x0012: "TLS_DHE_DSS_WITH_DES_CBC_SHA",
+	0x0013: "TLS_DHE_DSS_WITH_3DES_EDE_CBC_SHA",
+	0x0014: "TLS_DHE_RSA_EXPORT_WITH_DES40_CBC_SHA",
+	0x0015: "TLS_DHE_RSA_WITH_DES_CBC_SHA",
+	0x0016: "TLS_DHE_RSA_WITH_3DES_EDE_CBC_SHA",
+	0x0017: "TLS_DH_anon_EXPORT_WITH_RC4_40_MD5",
+	0x0018: "TLS_DH_anon_WITH_RC4_128_MD5",
+	0x0019: "TLS_DH_anon_EXPORT_WITH_DES40_CBC_SHA",
+	0x001A: "TLS_DH_anon_WITH_DES_CBC_SHA",
+	0x001B: "TLS_DH_anon_WITH_3DES_EDE_CBC_SHA",
+	0x001E: "TLS_KRB5_WITH_DES_CBC_SHA",
+	0x001F: "TLS_KRB5_WITH_3DES_EDE_CBC_SHA",
+	0x0020: "TLS_KRB5_WITH_RC4_128_SHA",
+	0x0021: "TLS_KRB5_WITH_IDEA_CBC_SHA",
+	0x0022: "TLS_KRB5_WITH_DES_CBC_MD5",
+	0x0023: "TLS_KRB5_WITH_3DES_EDE_CBC_MD5",
+	0x0024: "TLS_KRB5_WITH_RC4_128_MD5",
+	0x0025: "TLS_KRB5_WITH_IDEA_CBC_MD5",
+	0x0026: "TLS_KRB5_EXPORT_WITH_DES_CBC_40_SHA",
+	0x0027: "TLS_KRB5_EXPORT_WITH_RC2_CBC_40_SHA",
+	0x0028: "TLS_KRB5_EXPORT_WITH_RC4_40_SHA",
+	0x0029: "TLS_KRB5_EXPORT_WITH_DES_CBC_40_MD5",
+	0x002A: "TLS_KRB5_EXPORT_WITH_RC2_CBC_40_MD5",
+	0x002B: "TLS_KRB5_EXPORT_WITH_RC4_40_MD5",
+	0x002C: "TLS_PSK_WITH_NULL_SHA",
+	0x002D: "TLS_DHE_PSK_WITH_NULL_SHA",
+	0x002E: "TLS_RSA_PSK_WITH_NULL_SHA",
+	0x002F: "TLS_RSA_WITH_AES_128_CBC_SHA",
+	0x0030: "TLS_DH_DSS_WITH_AES_128_CBC_SHA",
+	0x0031: "TLS_DH_RSA_WITH_AES_128_CBC_SHA",
+	0x0032: "TLS_DHE_DSS_WITH_AES_128_CBC_SHA",
+	0x0033: "TLS_DHE_RSA_WITH_AES_128_CBC_SHA",
+	0x0034: "TLS_DH_anon_WITH_AES_128_CBC_SHA",
+	0x0035: "TLS_RSA_WITH_AES_256_CBC_SHA",
+	0x0036: "TLS_DH_DSS_WITH_AES_256_CBC_SHA",
+	0x0037: "TLS_DH_RSA_WITH_AES_256_CBC_SHA",
+	0x0038: "TLS_DHE_DSS_WITH_AES_256_CBC_SHA",
+	0x0039: "TLS_DHE_RSA_WITH_AES_256_CBC_SHA",
+	0x003A: "TLS_DH_anon_WITH_AES_256_CBC_SHA",
+	0x003B: "TLS_RSA_WITH_NULL_SHA256",
+	0x003C: "TLS_RSA_WITH_AES_128_CBC_SHA256",
+	0x003D: "TLS_RSA_WITH_AES_256_CBC_SHA256",
+	0x003E: "TLS_DH_DSS_WITH_AES_128_CBC_SHA256",
+	0x003F: "TLS_DH_RSA_WITH_AES_128_CBC_SHA256",
+	0x0040: "TLS_DHE_DSS_WITH_AES_128_CBC_SHA256",
+	0x0041: "TLS_RSA_WITH_CAMELLIA_128_CBC_SHA",
+	0x0042: "TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA",
+	0x0043: "TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA",
+	0x0044: "TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA",
+	0x0045: "TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA",
+	0x0046: "TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA",
+	0x0067: "TLS_DHE_RSA_WITH_AES_128_CBC_SHA256",
+	0x0068: "TLS_DH_DSS_WITH_AES_256_CBC_SHA256",
+	0x0069: "TLS_DH_RSA_WITH_AES_256_CBC_SHA256",
+	0x006A: "TLS_DHE_DSS_WITH_AES_256_CBC_SHA256",
+	0x006B: "TLS_DHE_RSA_WITH_AES_256_CBC_SHA256",
+	0x006C: "TLS_DH_anon_WITH_AES_128_CBC_SHA256",
+	0x006D: "TLS_DH_anon_WITH_AES_256_CBC_SHA256",
+	0x0084: "TLS_RSA_WITH_CAMELLIA_256_CBC_SHA",
+	0x0085: "TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA",
+	0x0086: "TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA",
+	0x0087: "TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA",
+	0x0088: "TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA",
+	0x0089: "TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA",
+	0x008A: "TLS_PSK_WITH_RC4_128_SHA",
+	0x008B: "TLS_PSK_WITH_3DES_EDE_CBC_SHA",
+	0x008C: "TLS_PSK_WITH_AES_128_CBC_SHA",
+	0x008D: "TLS_PSK_WITH_AES_256_CBC_SHA",
+	0x008E: "TLS_DHE_PSK_WITH_RC4_128_SHA",
+	0x008F: "TLS_DHE_PSK_WITH_3DES_EDE_CBC_SHA",
+	0x0090: "TLS_DHE_PSK_WITH_AES_128_CBC_SHA",
+	0x0091: "TLS_DHE_PSK_WITH_AES_256_CBC_SHA",
+	0x0092: "TLS_RSA_PSK_WITH_RC4_128_SHA",
+	0x0093: "TLS_RSA_PSK_WITH_3DES_EDE_CBC_SHA",
+	0x0094: "TLS_RSA_PSK_WITH_AES_128_CBC_SHA",
+	0x0095: "TLS_RSA_PSK_WITH_AES_256_CBC_SHA",
+	0x0096: "TLS_RSA_WITH_SEED_CBC_SHA",
+	0x0097: "TLS_DH_DSS_WITH_SEED_CBC_SHA",
+	0x0098: "TLS_DH_RSA_WITH_SEED_CBC_SHA",
+	0x0099: "TLS_DHE_DSS_WITH_SEED_CBC_SHA",
+	0x009A: "TLS_DHE_RSA_WITH_SEED_CBC_SHA",
+	0x009B: "TLS_DH_anon_WITH_SEED_CBC_SHA",
+	0x009C: "TLS_RSA_WITH_AES_128_GCM_SHA256",
+	0x009D: "TLS_RSA_WITH_AES_256_GCM_SHA384",
+	0x009E: "TLS_DHE_RSA_WITH_AES_128_GCM_SHA256",
+	0x009F: "TLS_DHE_RSA_WITH_AES_256_GCM_SHA384",
+	0x00A0: "TLS_DH_RSA_WITH_AES_128_GCM_SHA256",
+	0x00A1: "TLS_DH_RSA_WITH_AES_256_GCM_SHA384",
+	0x00A2: "TLS_DHE_DSS_WITH_AES_128_GCM_SHA256",
+	0x00A3: "TLS_DHE_DSS_WITH_AES_256_GCM_SHA384",
+	0x00A4: "TLS_DH_DSS_WITH_AES_128_GCM_SHA256",
+	0x00A5: "TLS_DH_DSS_WITH_AES_256_GCM_SHA384",
+	0x00A6: "TLS_DH_anon_WITH_AES_128_GCM_SHA256",
+	0x00A7: "TLS_DH_anon_WITH_AES_256_GCM_SHA384",
+	0x00A8: "TLS_PSK_WITH_AES_128_GCM_SHA256",
+	0x00A9: "TLS_PSK_WITH_AES_256_GCM_SHA384",
+	0x00AA: "TLS_DHE_PSK_WITH_AES_128_GCM_SHA256",
+	0x00AB: "TLS_DHE_PSK_WITH_AES_256_GCM_SHA384",
+	0x00AC: "TLS_RSA_PSK_WITH_AES_128_GCM_SHA256",
+	0x00AD: "TLS_RSA_PSK_WITH_AES_256_GCM_SHA384",
+	0x00AE: "TLS_PSK_WITH_AES_128_CBC_SHA256",
+	0x00AF: "TLS_PSK_WITH_AES_256_CBC_SHA384",
+	0x00B0: "TLS_PSK_WITH_NULL_SHA256",
+	0x00B1: "TLS_PSK_WITH_NULL_SHA384",
+	0x00B2: "TLS_DHE_PSK_WITH_AES_128_CBC_SHA256",
+	0x00B3: "TLS_DHE_PSK_WITH_AES_256_CBC_SHA384",
+	0x00B4: "TLS_DHE_PSK_WITH_NULL_SHA256",
+	0x00B5: "TLS_DHE_PSK_WITH_NULL_SHA384",
+	0x00B6: "TLS_RSA_PSK_WITH_AES_128_CBC_SHA256",
+	0x00B7: "TLS_RSA_PSK_WITH_AES_256_CBC_SHA384",
+	0x00B8: "TLS_RSA_PSK_WITH_NULL_SHA256",
+	0x00B9: "TLS_RSA_PSK_WITH_NULL_SHA384",
+	0x00BA: "TLS_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+	0x00BB: "TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA256",
+	0x00BC: "TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+	0x00BD: "TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA256",
+	0x00BE: "TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+	0x00BF: "TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA256",
+	0x00C0: "TLS_RSA_WITH_CAMELLIA_256_CBC_SHA256",
+	0x00C1: "TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA256",
+	0x00C2: "TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA256",
+	0x00C3: "TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA256",
+	0x00C4: "TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA256",
+	0x00C5: "TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA256",
+	0x00C6: "TLS_SM4_GCM_SM3",
+	0x00C7: "TLS_SM4_CCM_SM3",
+	0x00FF: "TLS_EMPTY_RENEGOTIATION_INFO_SCSV",
+	0x1301: "TLS_AES_128_GCM_SHA256",
+	0x1302: "TLS_AES_256_GCM_SHA384",
+	0x1303: "TLS_CHACHA20_POLY1305_SHA256",
+	0x1304: "TLS_AES_128_CCM_SHA256",
+	0x1305: "TLS_AES_128_CCM_8_SHA256",
+	0x5600: "TLS_FALLBACK_SCSV",
+	0xC001: "TLS_ECDH_ECDSA_WITH_NULL_SHA",
+	0xC002: "TLS_ECDH_ECDSA_WITH_RC4_128_SHA",
+	0xC003: "TLS_ECDH_ECDSA_WITH_3DES_EDE_CBC_SHA",
+	0xC004: "TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA",
+	0xC005: "TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA",
+	0xC006: "TLS_ECDHE_ECDSA_WITH_NULL_SHA",
+	0xC007: "TLS_ECDHE_ECDSA_WITH_RC4_128_SHA",
+	0xC008: "TLS_ECDHE_ECDSA_WITH_3DES_EDE_CBC_SHA",
+	0xC009: "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+	0xC00A: "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+	0xC00B: "TLS_ECDH_RSA_WITH_NULL_SHA",
+	0xC00C: "TLS_ECDH_RSA_WITH_RC4_128_SHA",
+	0xC00D: "TLS_ECDH_RSA_WITH_3DES_EDE_CBC_SHA",
+	0xC00E: "TLS_ECDH_RSA_WITH_AES_128_CBC_SHA",
+	0xC00F: "TLS_ECDH_RSA_WITH_AES_256_CBC_SHA",
+	0xC010: "TLS_ECDHE_RSA_WITH_NULL_SHA",
+	0xC011: "TLS_ECDHE_RSA_WITH_RC4_128_SHA",
+	0xC012: "TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA",
+	0xC013: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+	0xC014: "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+	0xC015: "TLS_ECDH_anon_WITH_NULL_SHA",
+	0xC016: "TLS_ECDH_anon_WITH_RC4_128_SHA",
+	0xC017: "TLS_ECDH_anon_WITH_3DES_EDE_CBC_SHA",
+	0xC018: "TLS_ECDH_anon_WITH_AES_128_CBC_SHA",
+	0xC019: "TLS_ECDH_anon_WITH_AES_256_CBC_SHA",
+	0xC01A: "TLS_SRP_SHA_WITH_3DES_EDE_CBC_SHA",
+	0xC01B: "TLS_SRP_SHA_RSA_WITH_3DES_EDE_CBC_SHA",
+	0xC01C: "TLS_SRP_SHA_DSS_WITH_3DES_EDE_CBC_SHA",
+	0xC01D: "TLS_SRP_SHA_WITH_AES_128_CBC_SHA",
+	0xC01E: "TLS_SRP_SHA_RSA_WITH_AES_128_CBC_SHA",
+	0xC01F: "TLS_SRP_SHA_DSS_WITH_AES_128_CBC_SHA",
+	0xC020: "TLS_SRP_SHA_WITH_AES_256_CBC_SHA",
+	0xC021: "TLS_SRP_SHA_RSA_WITH_AES_256_CBC_SHA",
+	0xC022: "TLS_SRP_SHA_DSS_WITH_AES_256_CBC_SHA",
+	0xC023: "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+	0xC024: "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384",
+	0xC025: "TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA256",
+	0xC026: "TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA384",
+	0xC027: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+	0xC028: "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA384",
+	0xC029: "TLS_ECDH_RSA_WITH_AES_128_CBC_SHA256",
+	0xC02A: "TLS_ECDH_RSA_WITH_AES_256_CBC_SHA384",
+	0xC02B: "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	0xC02C: "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	0xC02D: "TLS_ECDH_ECDSA_WITH_AES_128_GCM_SHA256",
+	0xC02E: "TLS_ECDH_ECDSA_WITH_AES_256_GCM_SHA384",
+	0xC02F: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	0xC030: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	0xC031: "TLS_ECDH_RSA_WITH_AES_128_GCM_SHA256",
+	0xC032: "TLS_ECDH_RSA_WITH_AES_256_GCM_SHA384",
+	0xC033: "TLS_ECDHE_PSK_WITH_RC4_128_SHA",
+	0xC034: "TLS_ECDHE_PSK_WITH_3DES_EDE_CBC_SHA",
+	0xC035: "TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA",
+	0xC036: "TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA",
+	0xC037: "TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA256",
+	0xC038: "TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA384",
+	0xC039: "TLS_ECDHE_PSK_WITH_NULL_SHA",
+	0xC03A: "TLS_ECDHE_PSK_WITH_NULL_SHA256",
+	0xC03B: "TLS_ECDHE_PSK_WITH_NULL_SHA384",
+	0xC03C: "TLS_RSA_WITH_ARIA_128_CBC_SHA256",
+	0xC03D: "TLS_RSA_WITH_ARIA_256_CBC_SHA384",
+	0xC03E: "TLS_DH_DSS_WITH_ARIA_128_CBC_SHA256",
+	0xC03F: "TLS_DH_DSS_WITH_ARIA_256_CBC_SHA384",
+	0xC040: "TLS_DH_RSA_WITH_ARIA_128_CBC_SHA256",
+	0xC041: "TLS_DH_RSA_WITH_ARIA_256_CBC_SHA384",
+	0xC042: "TLS_DHE_DSS_WITH_ARIA_128_CBC_SHA256",
+	0xC043: "TLS_DHE_DSS_WITH_ARIA_256_CBC_SHA384",
+	0xC044: "TLS_DHE_RSA_WITH_ARIA_128_CBC_SHA256",
+	0xC045: "TLS_DHE_RSA_WITH_ARIA_256_CBC_SHA384",
+	0xC046: "TLS_DH_anon_WITH_ARIA_128_CBC_SHA256",
+	0xC047: "TLS_DH_anon_WITH_ARIA_256_CBC_SHA384",
+	0xC048: "TLS_ECDHE_ECDSA_WITH_ARIA_128_CBC_SHA256",
+	0xC049: "TLS_ECDHE_ECDSA_WITH_ARIA_256_CBC_SHA384",
+	0xC04A: "TLS_ECDH_ECDSA_WITH_ARIA_128_CBC_SHA256",
+	0xC04B: "TLS_ECDH_ECDSA_WITH_ARIA_256_CBC_SHA384",
+	0xC04C: "TLS_ECDHE_RSA_WITH_ARIA_128_CBC_SHA256",
+	0xC04D: "TLS_ECDHE_RSA_WITH_ARIA_256_CBC_SHA384",
+	0xC04E: "TLS_ECDH_RSA_WITH_ARIA_128_CBC_SHA256",
+	0xC04F: "TLS_ECDH_RSA_WITH_ARIA_256_CBC_SHA384",
+	0xC050: "TLS_RSA_WITH_ARIA_128_GCM_SHA256",
+	0xC051: "TLS_RSA_WITH_ARIA_256_GCM_SHA384",
+	0xC052: "TLS_DHE_RSA_WITH_ARIA_128_GCM_SHA256",
+	0xC053: "TLS_DHE_RSA_WITH_ARIA_256_GCM_SHA384",
+	0xC054: "TLS_DH_RSA_WITH_ARIA_128_GCM_SHA256",
+	0xC055: "TLS_DH_RSA_WITH_ARIA_256_GCM_SHA384",
+	0xC056: "TLS_DHE_DSS_WITH_ARIA_128_GCM_SHA256",
+	0xC057: "TLS_DHE_DSS_WITH_ARIA_256_GCM_SHA384",
+	0xC058: "TLS_DH_DSS_WITH_ARIA_128_GCM_SHA256",
+	0xC059: "TLS_DH_DSS_WITH_ARIA_256_GCM_SHA384",
+	0xC05A: "TLS_DH_anon_WITH_ARIA_128_GCM_SHA256",
+	0xC05B: "TLS_DH_anon_WITH_ARIA_256_GCM_SHA384",
+	0xC05C: "TLS_ECDHE_ECDSA_WITH_ARIA_128_GCM_SHA256",
+	0xC05D: "TLS_ECDHE_ECDSA_WITH_ARIA_256_GCM_SHA384",
+	0xC05E: "TLS_ECDH_ECDSA_WITH_ARIA_128_GCM_SHA256",
+	0xC05F: "TLS_ECDH_ECDSA_WITH_ARIA_256_GCM_SHA384",
+	0xC060: "TLS_ECDHE_RSA_WITH_ARIA_128_GCM_SHA256",
+	0xC061: "TLS_ECDHE_RSA_WITH_ARIA_256_GCM_SHA384",
+	0xC062: "TLS_ECDH_RSA_WITH_ARIA_128_GCM_SHA256",
+	0xC063: "TLS_ECDH_RSA_WITH_ARIA_256_GCM_SHA384",
+	0xC064: "TLS_PSK_WITH_ARIA_128_CBC_SHA256",
+	0xC065: "TLS_PSK_WITH_ARIA_256_CBC_SHA384",
+	0xC066: "TLS_DHE_PSK_WITH_ARIA_128_CBC_SHA256",
+	0xC067: "TLS_DHE_PSK_WITH_ARIA_256_CBC_SHA384",
+	0xC068: "TLS_RSA_PSK_WITH_ARIA_128_CBC_SHA256",
+	0xC069: "TLS_RSA_PSK_WITH_ARIA_256_CBC_SHA384",
+	0xC06A: "TLS_PSK_WITH_ARIA_128_GCM_SHA256",
+	0xC06B: "TLS_PSK_WITH_ARIA_256_GCM_SHA384",
+	0xC06C: "TLS_DHE_PSK_WITH_ARIA_128_GCM_SHA256",
+	0xC06D: "TLS_DHE_PSK_WITH_ARIA_256_GCM_SHA384",
+	0xC06E: "TLS_RSA_PSK_WITH_ARIA_128_GCM_SHA256",
+	0xC06F: "TLS_RSA_PSK_WITH_ARIA_256_GCM_SHA384",
+	0xC070: "TLS_ECDHE_PSK_WITH_ARIA_128_CBC_SHA256",
+	0xC071: "TLS_ECDHE_PSK_WITH_ARIA_256_CBC_SHA384",
+	0xC072: "TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_CBC_SHA256",
+	0xC073: "TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_CBC_SHA384",
+	0xC074: "TLS_ECDH_ECDSA_WITH_CAMELLIA_128_CBC_SHA256",
+	0xC075: "TLS_ECDH_ECDSA_WITH_CAMELLIA_256_CBC_SHA384",
+	0xC076: "TLS_ECDHE_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+	0xC077: "TLS_ECDHE_RSA_WITH_CAMELLIA_256_CBC_SHA384",
+	0xC078: "TLS_ECDH_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+	0xC079: "TLS_ECDH_RSA_WITH_CAMELLIA_256_CBC_SHA384",
+	0xC07A: "TLS_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC07B: "TLS_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC07C: "TLS_DHE_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC07D: "TLS_DHE_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC07E: "TLS_DH_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC07F: "TLS_DH_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC080: "TLS_DHE_DSS_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC081: "TLS_DHE_DSS_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC082: "TLS_DH_DSS_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC083: "TLS_DH_DSS_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC084: "TLS_DH_anon_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC085: "TLS_DH_anon_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC086: "TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC087: "TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC088: "TLS_ECDH_ECDSA_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC089: "TLS_ECDH_ECDSA_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC08A: "TLS_ECDHE_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC08B: "TLS_ECDHE_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC08C: "TLS_ECDH_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC08D: "TLS_ECDH_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC08E: "TLS_PSK_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC08F: "TLS_PSK_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC090: "TLS_DHE_PSK_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC091: "TLS_DHE_PSK_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC092: "TLS_RSA_PSK_WITH_CAMELLIA_128_GCM_SHA256",
+	0xC093: "TLS_RSA_PSK_WITH_CAMELLIA_256_GCM_SHA384",
+	0xC094: "TLS_PSK_WITH_CAMELLIA_128_CBC_SHA256",
+	0xC095: "TLS_PSK_WITH_CAMELLIA_256_CBC_SHA384",
+	0xC096: "TLS_DHE_PSK_WITH_CAMELLIA_128_CBC_SHA256",
+	0xC097: "TLS_DHE_PSK_WITH_CAMELLIA_256_CBC_SHA384",
+	0xC098: "TLS_RSA_PSK_WITH_CAMELLIA_128_CBC_SHA256",
+	0xC099: "TLS_RSA_PSK_WITH_CAMELLIA_256_CBC_SHA384",
+	0xC09A: "TLS_ECDHE_PSK_WITH_CAMELLIA_128_CBC_SHA256",
+	0xC09B: "TLS_ECDHE_PSK_WITH_CAMELLIA_256_CBC_SHA384",
+	0xC09C: "TLS_RSA_WITH_AES_128_CCM",
+	0xC09D: "TLS_RSA_WITH_AES_256_CCM",
+	0xC09E: "TLS_DHE_RSA_WITH_AES_128_CCM",
+	0xC09F: "TLS_DHE_RSA_WITH_AES_256_CCM",
+	0xC0A0: "TLS_RSA_WITH_AES_128_CCM_8",
+	0xC0A1: "TLS_RSA_WITH_AES_256_CCM_8",
+	0xC0A2: "TLS_DHE_RSA_WITH_AES_128_CCM_8",
+	0xC0A3: "TLS_DHE_RSA_WITH_AES_256_CCM_8",
+	0xC0A4: "TLS_PSK_WITH_AES_128_CCM",
+	0xC0A5: "TLS_PSK_WITH_AES_256_CCM",
+	0xC0A6: "TLS_DHE_PSK_WITH_AES_128_CCM",
+	0xC0A7: "TLS_DHE_PSK_WITH_AES_256_CCM",
+	0xC0A8: "TLS_PSK_WITH_AES_128_CCM_8",
+	0xC0A9: "TLS_PSK_WITH_AES_256_CCM_8",
+	0xC0AA: "TLS_PSK_DHE_WITH_AES_128_CCM_8",
+	0xC0AB: "TLS_PSK_DHE_WITH_AES_256_CCM_8",
+	0xC0AC: "TLS_ECDHE_ECDSA_WITH_AES_128_CCM",
+	0xC0AD: "TLS_ECDHE_ECDSA_WITH_AES_256_CCM",
+	0xC0AE: "TLS_ECDHE_ECDSA_WITH_AES_128_CCM_8",
+	0xC0AF: "TLS_ECDHE_ECDSA_WITH_AES_256_CCM_8",
+	0xC0B0: "TLS_ECCPWD_WITH_AES_128_GCM_SHA256",
+	0xC0B1: "TLS_ECCPWD_WITH_AES_256_GCM_SHA384",
+	0xC0B2: "TLS_ECCPWD_WITH_AES_128_CCM_SHA256",
+	0xC0B3: "TLS_ECCPWD_WITH_AES_256_CCM_SHA384",
+	0xC0B4: "TLS_SHA256_SHA256",
+	0xC0B5: "TLS_SHA384_SHA384",
+	0xC100: "TLS_GOSTR341112_256_WITH_KUZNYECHIK_CTR_OMAC",
+	0xC101: "TLS_GOSTR341112_256_WITH_MAGMA_CTR_OMAC",
+	0xC102: "TLS_GOSTR341112_256_WITH_28147_CNT_IMIT",
+	0xC103: "TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_L",
+	0xC104: "TLS_GOSTR341112_256_WITH_MAGMA_MGM_L",
+	0xC105: "TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_S",
+	0xC106: "TLS_GOSTR341112_256_WITH_MAGMA_MGM_S",
+	0xCCA8: "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+	0xCCA9: "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+	0xCCAA: "TLS_DHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+	0xCCAB: "TLS_PSK_WITH_CHACHA20_POLY1305_SHA256",
+	0xCCAC: "TLS_ECDHE_PSK_WITH_CHACHA20_POLY1305_SHA256",
+	0xCCAD: "TLS_DHE_PSK_WITH_CHACHA20_POLY1305_SHA256",
+	0xCCAE: "TLS_RSA_PSK_WITH_CHACHA20_POLY1305_SHA256",
+	0xD001: "TLS_ECDHE_PSK_WITH_AES_128_GCM_SHA256",
+	0xD002: "TLS_ECDHE_PSK_WITH_AES_256_GCM_SHA384",
+	0xD003: "TLS_ECDHE_PSK_WITH_AES_128_CCM_8_SHA256",
+	0xD005: "TLS_ECDHE_PSK_WITH_AES_128_CCM_SHA256",
+}
+
+// groups is generated from tls-parameters-8.csv
+var groups = map[uint16]string{
+	0x0001: "sect163k1",
+	0x0002: "sect163r1",
+	0x0003: "sect163r2",
+	0x0004: "sect193r1",
+	0x0005: "sect193r2",
+	0x0006: "sect233k1",
+	0x0007: "sect233r1",
+	0x0008: "sect239k1",
+	0x0009: "sect283k1",
+	0x000A: "sect283r1",
+	0x000B: "sect409k1",
+	0x000C: "sect409r1",
+	0x000D: "sect571k1",
+	0x000E: "sect571r1",
+	0x000F: "secp160k1",
+	0x0010: "secp160r1",
+	0x0011: "secp160r2",
+	0x0012: "secp192k1",
+	0x0013: "secp192r1",
+	0x0014: "secp224k1",
+	0x0015: "secp224r1",
+	0x0016: "secp256k1",
+	0x0017: "secp256r1",
+	0x0018: "secp384r1",
+	0x0019: "secp521r1",
+	0x001A: "brainpoolP256r1",
+	0x001B: "brainpoolP384r1",
+	0x001C: "brainpoolP512r1",
+	0x001D: "x25519",
+	0x001E: "x448",
+	0x001F: "brainpoolP256r1tls13",
+	0x0020: "brainpoolP384r1tls13",
+	0x0021: "brainpoolP512r1tls13",
+	0x0022: "GC256A",
+	0x0023: "GC256B",
+	0x0024: "GC256C",
+	0x0025: "GC256D",
+	0x0026: "GC512A",
+	0x0027: "GC512B",
+	0x0028: "GC512C",
+	0x0029: "curveSM2",
+	0x0100: "ffdhe2048",
+	0x0101: "ffdhe3072",
+	0x0102: "ffdhe4096",
+	0x0103: "ffdhe6144",
+	0x0104: "ffdhe8192",
+	0x0200: "MLKEM512",
+	0x0201: "MLKEM768",
+	0x0202: "MLKEM1024",
+	0x11EB: "SecP256r1MLKEM768",
+	0x11EC: "X25519MLKEM768",
+	0x11ED: "SecP384r1MLKEM1024",
+	0x6399: "X25519Kyber768Draft00",
+	0x639A: "SecP256r1Kyber768Draft00",
+	0xFF01: "arbitrary_explicit_prime_curves",
+	0xFF02: "arbitrary_explicit_char2_curves",
+}
+
+// signatureSchemes is generated from tls-signaturescheme.csv
+var signatureSchemes = map[uint16]string{
+	0x0201: "rsa_pkcs1_sha1",
+	0x0203: "ecdsa_sha1",
+	0x0401: "rsa_pkcs1_sha256",
+	0x0403: "ecdsa_secp256r1_sha256",
+	0x0420: "rsa_pkcs1_sha256_legacy",
+	0x0501: "rsa_pkcs1_sha384",
+	0x0503: "ecdsa_secp384r1_sha384",
+	0x0520: "rsa_pkcs1_sha384_legacy",
+	0x0601: "rsa_pkcs1_sha512",
+	0x0603: "ecdsa_secp521r1_sha512",
+	0x0620: "rsa_pkcs1_sha512_legacy",
+	0x0704: "eccsi_sha256",
+	0x0705: "iso_ibs1",
+	0x0706: "iso_ibs2",
+	0x0707: "iso_chinese_ibs",
+	0x0708: "sm2sig_sm3",
+	0x0709: "gostr34102012_256a",
+	0x070A: "gostr34102012_256b",
+	0x070B: "gostr34102012_256c",
+	0x070C: "gostr34102012_256d",
+	0x070D: "gostr34102012_512a",
+	0x070E: "gostr34102012_512b",
+	0x070F: "gostr34102012_512c",
+	0x0804: "rsa_pss_rsae_sha256",
+	0x0805: "rsa_pss_rsae_sha384",
+	0x0806: "rsa_pss_rsae_sha512",
+	0x0807: "ed25519",
+	0x0808: "ed448",
+	0x0809: "rsa_pss_pss_sha256",
+	0x080A: "rsa_pss_pss_sha384",
+	0x080B: "rsa_pss_pss_sha512",
+	0x081A: "ecdsa_brainpoolP256r1tls13_sha256",
+	0x081B: "ecdsa_brainpoolP384r1tls13_sha384",
+	0x081C: "ecdsa_brainpoolP512r1tls13_sha512",
+	0x0904: "mldsa44",
+	0x0905: "mldsa65",
+	0x0906: "mldsa87",
+}
+
+// extensions is generated from tls-extensiontype-values-1.csv
+var extensions = map[uint16]string{
+	0x0000: "server_name",
+	0x0001: "max_fragment_length",
+	0x0002: "client_certificate_url",
+	0x0003: "trusted_ca_keys",
+	0x0004: "truncated_hmac",
+	0x0005: "status_request",
+	0x0006: "user_mapping",
+	0x0007: "client_authz",
+	0x0008: "server_authz",
+	0x0009: "cert_type",
+	0x000A: "supported_groups",
+	0x000B: "ec_point_formats",
+	0x000C: "srp",
+	0x000D: "signature_algorithms",
+	0x000E: "use_srtp",
+	0x000F: "heartbeat",
+	0x0010: "application_layer_protocol_negotiation",
+	0x0011: "status_request_v2",
+	0x0012: "signed_certificate_timestamp",
+	0x0013: "client_certificate_type",
+	0x0014: "server_certificate_type",
+	0x0015: "padding",
+	0x0016: "encrypt_then_mac",
+	0x0017: "extended_master_secret",
+	0x0018: "token_binding",
+	0x0019: "cached_info",
+	0x001A: "tls_lts",
+	0x001B: "compress_certificate",
+	0x001C: "record_size_limit",
+	0x001D: "pwd_protect",
+	0x001E: "pwd_clear",
+	0x001F: "password_salt",
+	0x0020: "ticket_pinning",
+	0x0021: "tls_cert_with_extern_psk",
+	0x0022: "delegated_credential",
+	0x0023: "session_ticket",
+	0x0024: "TLMSP",
+	0x0025: "TLMSP_proxying",
+	0x0026: "TLMSP_delegate",
+	0x0027: "supported_ekt_ciphers",
+	0x0029: "pre_shared_key",
+	0x002A: "early_data",
+	0x002B: "supported_versions",
+	0x002C: "cookie",
+	0x002D: "psk_key_exchange_modes",
+	0x002F: "certificate_authorities",
+	0x0030: "oid_filters",
+	0x0031: "post_handshake_auth",
+	0x0032: "signature_algorithms_cert",
+	0x0033: "key_share",
+	0x0034: "transparency_info",
+	0x0035: "connection_id (deprecated)",
+	0x0036: "connection_id",
+	0x0037: "external_id_hash",
+	0x0038: "external_session_id",
+	0x0039: "quic_transport_parameters",
+	0x003A: "ticket_request",
+	0x003B: "dnssec_chain",
+	0x003C: "sequence_number_encryption_algorithms",
+	0x003D: "rrc",
+	0xFD00: "ech_outer_extensions",
+	0xFE0D: "encrypted_client_hello",
+	0xFF01: "renegotiation_info",
+}
+
+// alerts is generated from tls-parameters-6.csv
+var alerts = map[uint8]string{
+	0x00: "close_notify",
+	0x0A: "unexpected_message",
+	0x14: "bad_record_mac",
+	0x15: "decryption_failed",
+	0x16: "record_overflow",
+	0x1E: "decompression_failure",
+	0x28: "handshake_failure",
+	0x29: "no_certificate",
+	0x2A: "bad_certificate",
+	0x2B: "unsupported_certificate",
+	0x2C: "certificate_revoked",
+	0x2D: "certificate_expired",
+	0x2E: "certificate_unknown",
+	0x2F: "illegal_parameter",
+	0x30: "unknown_ca",
+	0x31: "access_denied",
+	0x32: "decode_error",
+	0x33: "decrypt_error",
+	0x34: "too_many_cids_requested",
+	0x3C: "export_restriction",
+	0x46: "protocol_version",
+	0x47: "insufficient_security",
+	0x50: "internal_error",
+	0x56: "inappropriate_fallback",
+	0x5A: "user_canceled",
+	0x64: "no_renegotiation",
+	0x6D: "missing_extension",
+	0x6E: "unsupported_extension",
+	0x6F: "certificate_unobtainable",
+	0x70: "unrecognized_name",
+	0x71: "bad_certificate_status_response",
+	0x72: "bad_certificate_hash_value",
+	0x73: "unknown_psk_identity",
+	0x74: "certificate_required",
+	0x75: "general_error",
+	0x78: "no_application_protocol",
+	0x79: "ech_required",
+}